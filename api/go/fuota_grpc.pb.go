@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -24,11 +25,48 @@ const _ = grpc.SupportPackageIsVersion7
 type FuotaServerServiceClient interface {
 	// CreateDeployment creates the given FUOTA deployment.
 	CreateDeployment(ctx context.Context, in *CreateDeploymentRequest, opts ...grpc.CallOption) (*CreateDeploymentResponse, error)
+	// GetDeployment returns the FUOTA deployment options given an ID.
+	GetDeployment(ctx context.Context, in *GetDeploymentRequest, opts ...grpc.CallOption) (*GetDeploymentResponse, error)
 	// GetDeploymentStatus returns the FUOTA deployment status given an ID.
 	GetDeploymentStatus(ctx context.Context, in *GetDeploymentStatusRequest, opts ...grpc.CallOption) (*GetDeploymentStatusResponse, error)
 	// GetDeploymentDeviceLogs returns the FUOTA logs given a deployment ID and
 	// DevEUI.
 	GetDeploymentDeviceLogs(ctx context.Context, in *GetDeploymentDeviceLogsRequest, opts ...grpc.CallOption) (*GetDeploymentDeviceLogsResponse, error)
+	// GetDeploymentDeviceMulticastGroups returns the multicast-groups that were
+	// reported as active by the device (McGroupStatusAns) given a deployment ID
+	// and DevEUI.
+	GetDeploymentDeviceMulticastGroups(ctx context.Context, in *GetDeploymentDeviceMulticastGroupsRequest, opts ...grpc.CallOption) (*GetDeploymentDeviceMulticastGroupsResponse, error)
+	// CancelDeployment cancels the FUOTA deployment given an ID.
+	// Devices that were sent the multicast-group or fragmentation-session setup
+	// are requested to delete these (retried up to the unicast attempt count)
+	// and the multicast-group is removed from ChirpStack.
+	CancelDeployment(ctx context.Context, in *CancelDeploymentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListDeployments returns the FUOTA deployments matching the given filters.
+	ListDeployments(ctx context.Context, in *ListDeploymentsRequest, opts ...grpc.CallOption) (*ListDeploymentsResponse, error)
+	// WatchDeployment streams the events of the FUOTA deployment given an ID.
+	// The stream is closed after the DEPLOYMENT_ENDED event has been sent.
+	WatchDeployment(ctx context.Context, in *WatchDeploymentRequest, opts ...grpc.CallOption) (FuotaServerService_WatchDeploymentClient, error)
+	// RetryDeployment creates and starts a new FUOTA deployment using the
+	// options of the given (ended) deployment, including only the devices that
+	// did not complete the given step.
+	RetryDeployment(ctx context.Context, in *RetryDeploymentRequest, opts ...grpc.CallOption) (*RetryDeploymentResponse, error)
+	// CreateCampaign creates and starts the given staged rollout. The devices
+	// are split into waves, each wave is deployed as a separate FUOTA
+	// deployment. The next wave is only started when the success rate of the
+	// previous wave meets the success threshold.
+	CreateCampaign(ctx context.Context, in *CreateCampaignRequest, opts ...grpc.CallOption) (*CreateCampaignResponse, error)
+	// GetCampaignStatus returns the campaign status given an ID.
+	GetCampaignStatus(ctx context.Context, in *GetCampaignStatusRequest, opts ...grpc.CallOption) (*GetCampaignStatusResponse, error)
+	// UploadFirmware uploads a firmware image. The first message must contain
+	// the firmware details, the following messages contain the image chunks.
+	UploadFirmware(ctx context.Context, opts ...grpc.CallOption) (FuotaServerService_UploadFirmwareClient, error)
+	// GetFirmware returns the firmware given an ID.
+	GetFirmware(ctx context.Context, in *GetFirmwareRequest, opts ...grpc.CallOption) (*GetFirmwareResponse, error)
+	// ListFirmware returns the firmware images matching the given filters.
+	ListFirmware(ctx context.Context, in *ListFirmwareRequest, opts ...grpc.CallOption) (*ListFirmwareResponse, error)
+	// DeleteFirmware deletes the firmware given an ID. Deployments that were
+	// created using this firmware are not affected.
+	DeleteFirmware(ctx context.Context, in *DeleteFirmwareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type fuotaServerServiceClient struct {
@@ -48,6 +86,15 @@ func (c *fuotaServerServiceClient) CreateDeployment(ctx context.Context, in *Cre
 	return out, nil
 }
 
+func (c *fuotaServerServiceClient) GetDeployment(ctx context.Context, in *GetDeploymentRequest, opts ...grpc.CallOption) (*GetDeploymentResponse, error) {
+	out := new(GetDeploymentResponse)
+	err := c.cc.Invoke(ctx, "/fuota.FuotaServerService/GetDeployment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fuotaServerServiceClient) GetDeploymentStatus(ctx context.Context, in *GetDeploymentStatusRequest, opts ...grpc.CallOption) (*GetDeploymentStatusResponse, error) {
 	out := new(GetDeploymentStatusResponse)
 	err := c.cc.Invoke(ctx, "/fuota.FuotaServerService/GetDeploymentStatus", in, out, opts...)
@@ -66,17 +113,201 @@ func (c *fuotaServerServiceClient) GetDeploymentDeviceLogs(ctx context.Context,
 	return out, nil
 }
 
+func (c *fuotaServerServiceClient) GetDeploymentDeviceMulticastGroups(ctx context.Context, in *GetDeploymentDeviceMulticastGroupsRequest, opts ...grpc.CallOption) (*GetDeploymentDeviceMulticastGroupsResponse, error) {
+	out := new(GetDeploymentDeviceMulticastGroupsResponse)
+	err := c.cc.Invoke(ctx, "/fuota.FuotaServerService/GetDeploymentDeviceMulticastGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuotaServerServiceClient) CancelDeployment(ctx context.Context, in *CancelDeploymentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/fuota.FuotaServerService/CancelDeployment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuotaServerServiceClient) ListDeployments(ctx context.Context, in *ListDeploymentsRequest, opts ...grpc.CallOption) (*ListDeploymentsResponse, error) {
+	out := new(ListDeploymentsResponse)
+	err := c.cc.Invoke(ctx, "/fuota.FuotaServerService/ListDeployments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuotaServerServiceClient) WatchDeployment(ctx context.Context, in *WatchDeploymentRequest, opts ...grpc.CallOption) (FuotaServerService_WatchDeploymentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &FuotaServerService_ServiceDesc.Streams[0], "/fuota.FuotaServerService/WatchDeployment", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &fuotaServerServiceWatchDeploymentClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FuotaServerService_WatchDeploymentClient interface {
+	Recv() (*DeploymentEvent, error)
+	grpc.ClientStream
+}
+
+type fuotaServerServiceWatchDeploymentClient struct {
+	grpc.ClientStream
+}
+
+func (x *fuotaServerServiceWatchDeploymentClient) Recv() (*DeploymentEvent, error) {
+	m := new(DeploymentEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *fuotaServerServiceClient) RetryDeployment(ctx context.Context, in *RetryDeploymentRequest, opts ...grpc.CallOption) (*RetryDeploymentResponse, error) {
+	out := new(RetryDeploymentResponse)
+	err := c.cc.Invoke(ctx, "/fuota.FuotaServerService/RetryDeployment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuotaServerServiceClient) CreateCampaign(ctx context.Context, in *CreateCampaignRequest, opts ...grpc.CallOption) (*CreateCampaignResponse, error) {
+	out := new(CreateCampaignResponse)
+	err := c.cc.Invoke(ctx, "/fuota.FuotaServerService/CreateCampaign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuotaServerServiceClient) GetCampaignStatus(ctx context.Context, in *GetCampaignStatusRequest, opts ...grpc.CallOption) (*GetCampaignStatusResponse, error) {
+	out := new(GetCampaignStatusResponse)
+	err := c.cc.Invoke(ctx, "/fuota.FuotaServerService/GetCampaignStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuotaServerServiceClient) UploadFirmware(ctx context.Context, opts ...grpc.CallOption) (FuotaServerService_UploadFirmwareClient, error) {
+	stream, err := c.cc.NewStream(ctx, &FuotaServerService_ServiceDesc.Streams[1], "/fuota.FuotaServerService/UploadFirmware", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &fuotaServerServiceUploadFirmwareClient{stream}
+	return x, nil
+}
+
+type FuotaServerService_UploadFirmwareClient interface {
+	Send(*UploadFirmwareRequest) error
+	CloseAndRecv() (*UploadFirmwareResponse, error)
+	grpc.ClientStream
+}
+
+type fuotaServerServiceUploadFirmwareClient struct {
+	grpc.ClientStream
+}
+
+func (x *fuotaServerServiceUploadFirmwareClient) Send(m *UploadFirmwareRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *fuotaServerServiceUploadFirmwareClient) CloseAndRecv() (*UploadFirmwareResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadFirmwareResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *fuotaServerServiceClient) GetFirmware(ctx context.Context, in *GetFirmwareRequest, opts ...grpc.CallOption) (*GetFirmwareResponse, error) {
+	out := new(GetFirmwareResponse)
+	err := c.cc.Invoke(ctx, "/fuota.FuotaServerService/GetFirmware", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuotaServerServiceClient) ListFirmware(ctx context.Context, in *ListFirmwareRequest, opts ...grpc.CallOption) (*ListFirmwareResponse, error) {
+	out := new(ListFirmwareResponse)
+	err := c.cc.Invoke(ctx, "/fuota.FuotaServerService/ListFirmware", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuotaServerServiceClient) DeleteFirmware(ctx context.Context, in *DeleteFirmwareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/fuota.FuotaServerService/DeleteFirmware", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FuotaServerServiceServer is the server API for FuotaServerService service.
 // All implementations must embed UnimplementedFuotaServerServiceServer
 // for forward compatibility
 type FuotaServerServiceServer interface {
 	// CreateDeployment creates the given FUOTA deployment.
 	CreateDeployment(context.Context, *CreateDeploymentRequest) (*CreateDeploymentResponse, error)
+	// GetDeployment returns the FUOTA deployment options given an ID.
+	GetDeployment(context.Context, *GetDeploymentRequest) (*GetDeploymentResponse, error)
 	// GetDeploymentStatus returns the FUOTA deployment status given an ID.
 	GetDeploymentStatus(context.Context, *GetDeploymentStatusRequest) (*GetDeploymentStatusResponse, error)
 	// GetDeploymentDeviceLogs returns the FUOTA logs given a deployment ID and
 	// DevEUI.
 	GetDeploymentDeviceLogs(context.Context, *GetDeploymentDeviceLogsRequest) (*GetDeploymentDeviceLogsResponse, error)
+	// GetDeploymentDeviceMulticastGroups returns the multicast-groups that were
+	// reported as active by the device (McGroupStatusAns) given a deployment ID
+	// and DevEUI.
+	GetDeploymentDeviceMulticastGroups(context.Context, *GetDeploymentDeviceMulticastGroupsRequest) (*GetDeploymentDeviceMulticastGroupsResponse, error)
+	// CancelDeployment cancels the FUOTA deployment given an ID.
+	// Devices that were sent the multicast-group or fragmentation-session setup
+	// are requested to delete these (retried up to the unicast attempt count)
+	// and the multicast-group is removed from ChirpStack.
+	CancelDeployment(context.Context, *CancelDeploymentRequest) (*emptypb.Empty, error)
+	// ListDeployments returns the FUOTA deployments matching the given filters.
+	ListDeployments(context.Context, *ListDeploymentsRequest) (*ListDeploymentsResponse, error)
+	// WatchDeployment streams the events of the FUOTA deployment given an ID.
+	// The stream is closed after the DEPLOYMENT_ENDED event has been sent.
+	WatchDeployment(*WatchDeploymentRequest, FuotaServerService_WatchDeploymentServer) error
+	// RetryDeployment creates and starts a new FUOTA deployment using the
+	// options of the given (ended) deployment, including only the devices that
+	// did not complete the given step.
+	RetryDeployment(context.Context, *RetryDeploymentRequest) (*RetryDeploymentResponse, error)
+	// CreateCampaign creates and starts the given staged rollout. The devices
+	// are split into waves, each wave is deployed as a separate FUOTA
+	// deployment. The next wave is only started when the success rate of the
+	// previous wave meets the success threshold.
+	CreateCampaign(context.Context, *CreateCampaignRequest) (*CreateCampaignResponse, error)
+	// GetCampaignStatus returns the campaign status given an ID.
+	GetCampaignStatus(context.Context, *GetCampaignStatusRequest) (*GetCampaignStatusResponse, error)
+	// UploadFirmware uploads a firmware image. The first message must contain
+	// the firmware details, the following messages contain the image chunks.
+	UploadFirmware(FuotaServerService_UploadFirmwareServer) error
+	// GetFirmware returns the firmware given an ID.
+	GetFirmware(context.Context, *GetFirmwareRequest) (*GetFirmwareResponse, error)
+	// ListFirmware returns the firmware images matching the given filters.
+	ListFirmware(context.Context, *ListFirmwareRequest) (*ListFirmwareResponse, error)
+	// DeleteFirmware deletes the firmware given an ID. Deployments that were
+	// created using this firmware are not affected.
+	DeleteFirmware(context.Context, *DeleteFirmwareRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedFuotaServerServiceServer()
 }
 
@@ -87,12 +318,48 @@ type UnimplementedFuotaServerServiceServer struct {
 func (UnimplementedFuotaServerServiceServer) CreateDeployment(context.Context, *CreateDeploymentRequest) (*CreateDeploymentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateDeployment not implemented")
 }
+func (UnimplementedFuotaServerServiceServer) GetDeployment(context.Context, *GetDeploymentRequest) (*GetDeploymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeployment not implemented")
+}
 func (UnimplementedFuotaServerServiceServer) GetDeploymentStatus(context.Context, *GetDeploymentStatusRequest) (*GetDeploymentStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDeploymentStatus not implemented")
 }
 func (UnimplementedFuotaServerServiceServer) GetDeploymentDeviceLogs(context.Context, *GetDeploymentDeviceLogsRequest) (*GetDeploymentDeviceLogsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDeploymentDeviceLogs not implemented")
 }
+func (UnimplementedFuotaServerServiceServer) GetDeploymentDeviceMulticastGroups(context.Context, *GetDeploymentDeviceMulticastGroupsRequest) (*GetDeploymentDeviceMulticastGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeploymentDeviceMulticastGroups not implemented")
+}
+func (UnimplementedFuotaServerServiceServer) CancelDeployment(context.Context, *CancelDeploymentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDeployment not implemented")
+}
+func (UnimplementedFuotaServerServiceServer) ListDeployments(context.Context, *ListDeploymentsRequest) (*ListDeploymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeployments not implemented")
+}
+func (UnimplementedFuotaServerServiceServer) WatchDeployment(*WatchDeploymentRequest, FuotaServerService_WatchDeploymentServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchDeployment not implemented")
+}
+func (UnimplementedFuotaServerServiceServer) RetryDeployment(context.Context, *RetryDeploymentRequest) (*RetryDeploymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryDeployment not implemented")
+}
+func (UnimplementedFuotaServerServiceServer) CreateCampaign(context.Context, *CreateCampaignRequest) (*CreateCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCampaign not implemented")
+}
+func (UnimplementedFuotaServerServiceServer) GetCampaignStatus(context.Context, *GetCampaignStatusRequest) (*GetCampaignStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCampaignStatus not implemented")
+}
+func (UnimplementedFuotaServerServiceServer) UploadFirmware(FuotaServerService_UploadFirmwareServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadFirmware not implemented")
+}
+func (UnimplementedFuotaServerServiceServer) GetFirmware(context.Context, *GetFirmwareRequest) (*GetFirmwareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFirmware not implemented")
+}
+func (UnimplementedFuotaServerServiceServer) ListFirmware(context.Context, *ListFirmwareRequest) (*ListFirmwareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFirmware not implemented")
+}
+func (UnimplementedFuotaServerServiceServer) DeleteFirmware(context.Context, *DeleteFirmwareRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFirmware not implemented")
+}
 func (UnimplementedFuotaServerServiceServer) mustEmbedUnimplementedFuotaServerServiceServer() {}
 
 // UnsafeFuotaServerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -124,6 +391,24 @@ func _FuotaServerService_CreateDeployment_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FuotaServerService_GetDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuotaServerServiceServer).GetDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fuota.FuotaServerService/GetDeployment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuotaServerServiceServer).GetDeployment(ctx, req.(*GetDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FuotaServerService_GetDeploymentStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDeploymentStatusRequest)
 	if err := dec(in); err != nil {
@@ -160,6 +445,215 @@ func _FuotaServerService_GetDeploymentDeviceLogs_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FuotaServerService_GetDeploymentDeviceMulticastGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeploymentDeviceMulticastGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuotaServerServiceServer).GetDeploymentDeviceMulticastGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fuota.FuotaServerService/GetDeploymentDeviceMulticastGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuotaServerServiceServer).GetDeploymentDeviceMulticastGroups(ctx, req.(*GetDeploymentDeviceMulticastGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuotaServerService_CancelDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuotaServerServiceServer).CancelDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fuota.FuotaServerService/CancelDeployment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuotaServerServiceServer).CancelDeployment(ctx, req.(*CancelDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuotaServerService_ListDeployments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeploymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuotaServerServiceServer).ListDeployments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fuota.FuotaServerService/ListDeployments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuotaServerServiceServer).ListDeployments(ctx, req.(*ListDeploymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuotaServerService_WatchDeployment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchDeploymentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FuotaServerServiceServer).WatchDeployment(m, &fuotaServerServiceWatchDeploymentServer{stream})
+}
+
+type FuotaServerService_WatchDeploymentServer interface {
+	Send(*DeploymentEvent) error
+	grpc.ServerStream
+}
+
+type fuotaServerServiceWatchDeploymentServer struct {
+	grpc.ServerStream
+}
+
+func (x *fuotaServerServiceWatchDeploymentServer) Send(m *DeploymentEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _FuotaServerService_RetryDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuotaServerServiceServer).RetryDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fuota.FuotaServerService/RetryDeployment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuotaServerServiceServer).RetryDeployment(ctx, req.(*RetryDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuotaServerService_CreateCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuotaServerServiceServer).CreateCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fuota.FuotaServerService/CreateCampaign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuotaServerServiceServer).CreateCampaign(ctx, req.(*CreateCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuotaServerService_GetCampaignStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCampaignStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuotaServerServiceServer).GetCampaignStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fuota.FuotaServerService/GetCampaignStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuotaServerServiceServer).GetCampaignStatus(ctx, req.(*GetCampaignStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuotaServerService_UploadFirmware_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(FuotaServerServiceServer).UploadFirmware(&fuotaServerServiceUploadFirmwareServer{stream})
+}
+
+type FuotaServerService_UploadFirmwareServer interface {
+	SendAndClose(*UploadFirmwareResponse) error
+	Recv() (*UploadFirmwareRequest, error)
+	grpc.ServerStream
+}
+
+type fuotaServerServiceUploadFirmwareServer struct {
+	grpc.ServerStream
+}
+
+func (x *fuotaServerServiceUploadFirmwareServer) SendAndClose(m *UploadFirmwareResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *fuotaServerServiceUploadFirmwareServer) Recv() (*UploadFirmwareRequest, error) {
+	m := new(UploadFirmwareRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _FuotaServerService_GetFirmware_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFirmwareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuotaServerServiceServer).GetFirmware(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fuota.FuotaServerService/GetFirmware",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuotaServerServiceServer).GetFirmware(ctx, req.(*GetFirmwareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuotaServerService_ListFirmware_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFirmwareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuotaServerServiceServer).ListFirmware(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fuota.FuotaServerService/ListFirmware",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuotaServerServiceServer).ListFirmware(ctx, req.(*ListFirmwareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuotaServerService_DeleteFirmware_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFirmwareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuotaServerServiceServer).DeleteFirmware(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fuota.FuotaServerService/DeleteFirmware",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuotaServerServiceServer).DeleteFirmware(ctx, req.(*DeleteFirmwareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FuotaServerService_ServiceDesc is the grpc.ServiceDesc for FuotaServerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -171,6 +665,10 @@ var FuotaServerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateDeployment",
 			Handler:    _FuotaServerService_CreateDeployment_Handler,
 		},
+		{
+			MethodName: "GetDeployment",
+			Handler:    _FuotaServerService_GetDeployment_Handler,
+		},
 		{
 			MethodName: "GetDeploymentStatus",
 			Handler:    _FuotaServerService_GetDeploymentStatus_Handler,
@@ -179,7 +677,54 @@ var FuotaServerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDeploymentDeviceLogs",
 			Handler:    _FuotaServerService_GetDeploymentDeviceLogs_Handler,
 		},
+		{
+			MethodName: "GetDeploymentDeviceMulticastGroups",
+			Handler:    _FuotaServerService_GetDeploymentDeviceMulticastGroups_Handler,
+		},
+		{
+			MethodName: "CancelDeployment",
+			Handler:    _FuotaServerService_CancelDeployment_Handler,
+		},
+		{
+			MethodName: "ListDeployments",
+			Handler:    _FuotaServerService_ListDeployments_Handler,
+		},
+		{
+			MethodName: "RetryDeployment",
+			Handler:    _FuotaServerService_RetryDeployment_Handler,
+		},
+		{
+			MethodName: "CreateCampaign",
+			Handler:    _FuotaServerService_CreateCampaign_Handler,
+		},
+		{
+			MethodName: "GetCampaignStatus",
+			Handler:    _FuotaServerService_GetCampaignStatus_Handler,
+		},
+		{
+			MethodName: "GetFirmware",
+			Handler:    _FuotaServerService_GetFirmware_Handler,
+		},
+		{
+			MethodName: "ListFirmware",
+			Handler:    _FuotaServerService_ListFirmware_Handler,
+		},
+		{
+			MethodName: "DeleteFirmware",
+			Handler:    _FuotaServerService_DeleteFirmware_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchDeployment",
+			Handler:       _FuotaServerService_WatchDeployment_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadFirmware",
+			Handler:       _FuotaServerService_UploadFirmware_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "fuota.proto",
 }
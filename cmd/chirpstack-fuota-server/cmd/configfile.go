@@ -82,6 +82,15 @@ const configTemplate = `[general]
   # pool (0 = no idle connections are retained).
   max_idle_connections={{ .PostgreSQL.MaxIdleConnections }}
 
+  # Encryption key.
+  #
+  # HEX encoded AES key (16, 24 or 32 bytes) used for encrypting the
+  # multicast keys (McKey) and the McRootKeys of the devices stored in the
+  # database. When not set, these keys are stored unencrypted. Note that
+  # keys stored before the encryption key was set remain readable, but keys
+  # stored using an encryption key can not be read without it.
+  encryption_key="{{ .PostgreSQL.EncryptionKey }}"
+
 
 # ChirpStack (integration) settings.
 [chirpstack]
@@ -129,6 +138,39 @@ const configTemplate = `[general]
     tls_cert="{{ .FUOTAServer.API.TLSCert }}"
     tls_key="{{ .FUOTAServer.API.TLSKey }}"
 
+    # Max. firmware size (bytes).
+    #
+    # Firmware uploads exceeding this size are rejected. Set this to 0 to
+    # disable the limit.
+    max_firmware_size={{ .FUOTAServer.API.MaxFirmwareSize }}
+
+  # Deployment scheduler settings.
+  [fuota_server.scheduler]
+
+    # Interval.
+    #
+    # This defines the interval in which the scheduler checks for pending
+    # deployments of which the start time has passed.
+    interval="{{ .FUOTAServer.Scheduler.Interval }}"
+
+  # Payload signing settings.
+  [fuota_server.signing]
+
+    # Signing key.
+    #
+    # PEM encoded ECDSA P-256 private key (SEC 1 or PKCS #8), used for
+    # wrapping the payload of deployments in a signed envelope (sign_payload
+    # deployment option).
+    key_file="{{ .FUOTAServer.Signing.KeyFile }}"
+
+    # Trusted keys.
+    #
+    # PEM encoded ECDSA P-256 public keys (PKIX). When set, uploaded firmware
+    # must be signed by one of these keys.
+    trusted_key_files=[
+{{ range $index, $elm := .FUOTAServer.Signing.TrustedKeyFiles }}      "{{ $elm }}",
+{{ end }}    ]
+
 `
 
 var configCmd = &cobra.Command{
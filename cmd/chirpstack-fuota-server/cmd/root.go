@@ -42,6 +42,8 @@ func init() {
 	viper.SetDefault("chirpstack.event_handler.http.bind", "0.0.0.0:8090")
 	viper.SetDefault("chirpstack.api.server", "localhost:8080")
 	viper.SetDefault("fuota_server.api.bind", "0.0.0.0:8070")
+	viper.SetDefault("fuota_server.api.max_firmware_size", 10485760)
+	viper.SetDefault("fuota_server.scheduler.interval", "10s")
 
 	rootCmd.AddCommand(configCmd)
 	rootCmd.AddCommand(versionCmd)
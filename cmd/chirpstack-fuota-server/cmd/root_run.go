@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/signal"
@@ -13,10 +14,16 @@ import (
 	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/client/as"
 	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/config"
 	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/eventhandler"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/fuota"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/scheduler"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/signing"
 	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/storage"
 )
 
 func run(cmd *cobra.Command, args []string) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
 	tasks := []func() error{
 		setLogLevel,
 		setSyslog,
@@ -24,6 +31,10 @@ func run(cmd *cobra.Command, args []string) error {
 		setupStorage,
 		setupApplicationServerClient,
 		setupEventHandler,
+		resumeDeployments,
+		resumeCampaigns,
+		setupScheduler(ctx),
+		setupSigning,
 		setupAPI,
 	}
 
@@ -73,6 +84,36 @@ func setupApplicationServerClient() error {
 	return nil
 }
 
+func resumeDeployments() error {
+	if err := fuota.ResumeDeployments(context.Background()); err != nil {
+		return fmt.Errorf("resume deployments error: %w", err)
+	}
+	return nil
+}
+
+func resumeCampaigns() error {
+	if err := fuota.ResumeCampaigns(context.Background()); err != nil {
+		return fmt.Errorf("resume campaigns error: %w", err)
+	}
+	return nil
+}
+
+func setupScheduler(ctx context.Context) func() error {
+	return func() error {
+		if err := scheduler.Setup(ctx, &config.C); err != nil {
+			return fmt.Errorf("setup scheduler error: %w", err)
+		}
+		return nil
+	}
+}
+
+func setupSigning() error {
+	if err := signing.Setup(&config.C); err != nil {
+		return fmt.Errorf("setup signing error: %w", err)
+	}
+	return nil
+}
+
 func setupAPI() error {
 	if err := api.Setup(&config.C); err != nil {
 		return fmt.Errorf("setup api error: %w", err)
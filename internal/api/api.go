@@ -38,7 +38,7 @@ func Setup(conf *config.Config) error {
 	}
 
 	gs := grpc.NewServer(opts...)
-	fuotaAPI := NewFUOTAServerAPI()
+	fuotaAPI := NewFUOTAServerAPI(apiConf.MaxFirmwareSize)
 	fuota.RegisterFuotaServerServiceServer(gs, fuotaAPI)
 
 	ln, err := net.Listen("tcp", apiConf.Bind)
@@ -1,15 +1,27 @@
 package api
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"io"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/golang/protobuf/ptypes"
-	log "github.com/sirupsen/logrus"
+	"github.com/lib/pq/hstore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/brocaar/lorawan"
 	fapi "github.com/chirpstack/chirpstack-fuota-server/v4/api/go"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/delta"
 	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/fuota"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/signing"
 	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/storage"
 	"github.com/chirpstack/chirpstack/api/go/v4/api"
 	"github.com/chirpstack/chirpstack/api/go/v4/common"
@@ -18,42 +30,89 @@ import (
 // FUOTAServerAPI implements the FUOTA server API.
 type FUOTAServerAPI struct {
 	fapi.UnimplementedFuotaServerServiceServer
+
+	maxFirmwareSize int
 }
 
-// NewFUOTAServerAPI creates a new FUOTAServerAPI.
-func NewFUOTAServerAPI() *FUOTAServerAPI {
-	return &FUOTAServerAPI{}
+// NewFUOTAServerAPI creates a new FUOTAServerAPI. Firmware uploads exceeding
+// maxFirmwareSize bytes are rejected (0 = no limit).
+func NewFUOTAServerAPI(maxFirmwareSize int) *FUOTAServerAPI {
+	return &FUOTAServerAPI{
+		maxFirmwareSize: maxFirmwareSize,
+	}
 }
 
 // CreateDeployment creates the given FUOTA deployment.
 func (a *FUOTAServerAPI) CreateDeployment(ctx context.Context, req *fapi.CreateDeploymentRequest) (*fapi.CreateDeploymentResponse, error) {
+	opts, err := deploymentOptions(ctx, req.GetDeployment())
+	if err != nil {
+		return nil, err
+	}
+
+	depl, err := fuota.NewDeployment(opts)
+	if errors.Is(err, fuota.ErrChunkDescriptor) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// scheduled deployments are started by the scheduler
+	if !opts.StartAt.After(time.Now()) {
+		fuota.Start(depl)
+	}
+
+	return &fapi.CreateDeploymentResponse{
+		Id: depl.GetID().String(),
+	}, nil
+}
+
+// deploymentOptions returns the fuota.DeploymentOptions for the given API
+// deployment. The devices matching the device selector are added to the
+// devices of the deployment.
+func deploymentOptions(ctx context.Context, d *fapi.Deployment) (fuota.DeploymentOptions, error) {
 	opts := fuota.DeploymentOptions{
-		ApplicationID:                     req.GetDeployment().ApplicationId,
+		ApplicationID:                     d.ApplicationId,
 		Devices:                           make(map[lorawan.EUI64]fuota.DeviceOptions),
-		MulticastDR:                       uint8(req.GetDeployment().MulticastDr),
-		MulticastFrequency:                req.GetDeployment().MulticastFrequency,
-		MulticastGroupID:                  uint8(req.GetDeployment().MulticastGroupId),
-		MulticastTimeout:                  uint8(req.GetDeployment().MulticastTimeout),
-		MulticastRegion:                   common.Region(req.GetDeployment().MulticastRegion),
-		FragSize:                          int(req.GetDeployment().FragmentationFragmentSize),
-		Payload:                           req.GetDeployment().Payload,
-		Redundancy:                        int(req.GetDeployment().FragmentationRedundancy),
-		FragmentationSessionIndex:         uint8(req.GetDeployment().FragmentationSessionIndex),
-		FragmentationMatrix:               uint8(req.GetDeployment().FragmentationMatrix),
-		BlockAckDelay:                     uint8(req.GetDeployment().FragmentationBlockAckDelay),
-		UnicastAttemptCount:               int(req.GetDeployment().UnicastAttemptCount),
-		RequestFragmentationSessionStatus: fuota.FragmentationSessionStatusRequestType(req.GetDeployment().RequestFragmentationSessionStatus.String()),
-	}
-
-	for _, d := range req.GetDeployment().Devices {
+		MulticastDR:                       uint8(d.MulticastDr),
+		MulticastFrequency:                d.MulticastFrequency,
+		MulticastGroupID:                  uint8(d.MulticastGroupId),
+		MulticastTimeout:                  uint8(d.MulticastTimeout),
+		MulticastRegion:                   common.Region(d.MulticastRegion),
+		FragSize:                          int(d.FragmentationFragmentSize),
+		Payload:                           d.Payload,
+		Redundancy:                        int(d.FragmentationRedundancy),
+		FragmentationSessionIndex:         uint8(d.FragmentationSessionIndex),
+		FragmentationMatrix:               uint8(d.FragmentationMatrix),
+		BlockAckDelay:                     uint8(d.FragmentationBlockAckDelay),
+		UnicastAttemptCount:               int(d.UnicastAttemptCount),
+		RequestFragmentationSessionStatus: fuota.FragmentationSessionStatusRequestType(d.RequestFragmentationSessionStatus.String()),
+		RequestPackageVersions:            d.RequestPackageVersions,
+		AutoMulticastGroupID:              d.AutoMulticastGroupId,
+		RepairAttemptCount:                int(d.RepairAttemptCount),
+		FragmentationPackageVersion:       uint8(d.FragmentationPackageVersion),
+		MulticastSetupPackageVersion:      uint8(d.MulticastSetupPackageVersion),
+		FirmwareManagement:                d.FirmwareManagement,
+		FirmwareVersion:                   d.FirmwareVersion,
+		Compression:                       fuota.CompressionType(d.Compression.String()),
+		ChunkSize:                         int(d.ChunkSize),
+	}
+
+	var derive []lorawan.EUI64
+	for _, dev := range d.Devices {
 		var devEUI lorawan.EUI64
-		if err := devEUI.UnmarshalText([]byte(d.DevEui)); err != nil {
-			return nil, err
+		if err := devEUI.UnmarshalText([]byte(dev.DevEui)); err != nil {
+			return opts, status.Errorf(codes.InvalidArgument, "dev_eui: %s", err)
+		}
+
+		if d.DeriveMcRootKeys {
+			derive = append(derive, devEUI)
+			continue
 		}
 
 		var mcRootKey lorawan.AES128Key
-		if err := mcRootKey.UnmarshalText([]byte(d.McRootKey)); err != nil {
-			return nil, err
+		if err := mcRootKey.UnmarshalText([]byte(dev.McRootKey)); err != nil {
+			return opts, status.Errorf(codes.InvalidArgument, "mc_root_key: %s", err)
 		}
 
 		opts.Devices[devEUI] = fuota.DeviceOptions{
@@ -61,36 +120,295 @@ func (a *FUOTAServerAPI) CreateDeployment(ctx context.Context, req *fapi.CreateD
 		}
 	}
 
-	switch req.GetDeployment().MulticastGroupType {
+	if len(derive) != 0 {
+		devices, err := fuota.DeriveMcRootKeys(ctx, derive, opts.MulticastSetupPackageVersion)
+		if err != nil {
+			return opts, err
+		}
+		for devEUI, devOpts := range devices {
+			opts.Devices[devEUI] = devOpts
+		}
+	}
+
+	if sel := d.GetDeviceSelector(); sel != nil {
+		devices, err := fuota.SelectDevices(ctx, d.ApplicationId, fuota.DeviceSelector{
+			DeviceProfileID: sel.DeviceProfileId,
+			Tags:            sel.Tags,
+		}, opts.MulticastSetupPackageVersion)
+		if err != nil {
+			return opts, err
+		}
+
+		for devEUI, devOpts := range devices {
+			// explicitly given devices take precedence
+			if _, ok := opts.Devices[devEUI]; !ok {
+				opts.Devices[devEUI] = devOpts
+			}
+		}
+
+		if len(opts.Devices) == 0 {
+			return opts, status.Error(codes.InvalidArgument, "no devices matched the device selector")
+		}
+	}
+
+	switch d.MulticastGroupType {
 	case fapi.MulticastGroupType_CLASS_B:
+		if d.MulticastPingSlotPeriod > 7 {
+			return opts, status.Error(codes.InvalidArgument, "multicast_ping_slot_period must be between 0 and 7")
+		}
+
 		opts.MulticastGroupType = api.MulticastGroupType_CLASS_B
+		opts.MulticastPingSlotPeriodicity = uint8(d.MulticastPingSlotPeriod)
 	case fapi.MulticastGroupType_CLASS_C:
 		opts.MulticastGroupType = api.MulticastGroupType_CLASS_C
 	}
 
-	copy(opts.Descriptor[:], req.GetDeployment().FragmentationDescriptor)
+	if d.MulticastTimeout > 15 {
+		return opts, status.Error(codes.InvalidArgument, "multicast_timeout must be between 0 and 15")
+	}
+
+	if d.FragmentationPackageVersion > 2 {
+		return opts, status.Error(codes.InvalidArgument, "fragmentation_package_version must be 1 or 2")
+	}
+
+	if d.MulticastSetupPackageVersion > 2 {
+		return opts, status.Error(codes.InvalidArgument, "multicast_setup_package_version must be 1 or 2")
+	}
+
+	if d.Compression != fapi.PayloadCompression_NO_COMPRESSION && len(d.FragmentationDescriptor) != 0 && d.FragmentationDescriptor[0]&0xf0 != 0 {
+		return opts, status.Error(codes.InvalidArgument, "the 4 most significant bits of the fragmentation_descriptor are reserved for the compression")
+	}
 
-	unicastTimeout, err := ptypes.Duration(req.GetDeployment().UnicastTimeout)
+	var firmware *storage.Firmware
+	if d.FirmwareId != "" {
+		if len(d.Payload) != 0 {
+			return opts, status.Error(codes.InvalidArgument, "payload and firmware_id are mutually exclusive")
+		}
+
+		firmwareID, err := uuid.FromString(d.FirmwareId)
+		if err != nil {
+			return opts, status.Errorf(codes.InvalidArgument, "firmware_id: %s", err)
+		}
+
+		f, err := storage.GetFirmware(ctx, storage.DB(), firmwareID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return opts, status.Errorf(codes.NotFound, "firmware_id: firmware does not exist")
+		}
+		if err != nil {
+			return opts, err
+		}
+
+		firmware = &f
+		opts.Payload = f.Payload
+		opts.FirmwareID = &f.ID
+	}
+
+	if d.DeltaBaseFirmwareId != "" {
+		if len(d.FragmentationDescriptor) != 0 {
+			return opts, status.Error(codes.InvalidArgument, "fragmentation_descriptor can not be set when using delta_base_firmware_id")
+		}
+
+		baseID, err := uuid.FromString(d.DeltaBaseFirmwareId)
+		if err != nil {
+			return opts, status.Errorf(codes.InvalidArgument, "delta_base_firmware_id: %s", err)
+		}
+
+		base, err := storage.GetFirmware(ctx, storage.DB(), baseID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return opts, status.Errorf(codes.NotFound, "delta_base_firmware_id: firmware does not exist")
+		}
+		if err != nil {
+			return opts, err
+		}
+
+		if firmware != nil && firmware.Hardware != base.Hardware {
+			return opts, status.Error(codes.InvalidArgument, "firmware_id and delta_base_firmware_id must target the same hardware")
+		}
+
+		opts.Payload = delta.Diff(base.Payload, opts.Payload)
+		opts.Descriptor = delta.Descriptor(base.Payload)
+	} else {
+		copy(opts.Descriptor[:], d.FragmentationDescriptor)
+	}
+
+	if d.SignPayload {
+		payload, err := signing.Wrap(opts.Payload)
+		if err != nil {
+			return opts, err
+		}
+		opts.Payload = payload
+		opts.PayloadSigned = true
+	}
+
+	unicastTimeout, err := ptypes.Duration(d.UnicastTimeout)
 	if err != nil {
-		return nil, err
+		return opts, status.Errorf(codes.InvalidArgument, "unicast_timeout: %s", err)
 	}
 
 	opts.UnicastTimeout = unicastTimeout
 
-	depl, err := fuota.NewDeployment(opts)
+	if d.RebootAt != nil {
+		rebootAt, err := ptypes.Timestamp(d.RebootAt)
+		if err != nil {
+			return opts, status.Errorf(codes.InvalidArgument, "reboot_at: %s", err)
+		}
+		opts.RebootAt = &rebootAt
+	}
+
+	if d.RebootCountdown != nil {
+		rebootCountdown, err := ptypes.Duration(d.RebootCountdown)
+		if err != nil {
+			return opts, status.Errorf(codes.InvalidArgument, "reboot_countdown: %s", err)
+		}
+
+		// the countdown is encoded as 24 bits (seconds)
+		if rebootCountdown < 0 || rebootCountdown > ((1<<24)-1)*time.Second {
+			return opts, status.Error(codes.InvalidArgument, "reboot_countdown must be between 0 and 16777215 seconds")
+		}
+		opts.RebootCountdown = rebootCountdown
+	}
+
+	now := time.Now()
+	opts.StartAt = &now
+	if d.StartAt != nil {
+		startAt, err := ptypes.Timestamp(d.StartAt)
+		if err != nil {
+			return opts, status.Errorf(codes.InvalidArgument, "start_at: %s", err)
+		}
+		opts.StartAt = &startAt
+	}
+
+	for _, w := range d.MaintenanceWindows {
+		start, err := ptypes.Timestamp(w.GetStart())
+		if err != nil {
+			return opts, status.Errorf(codes.InvalidArgument, "maintenance window start: %s", err)
+		}
+		end, err := ptypes.Timestamp(w.GetEnd())
+		if err != nil {
+			return opts, status.Errorf(codes.InvalidArgument, "maintenance window end: %s", err)
+		}
+		if !end.After(start) {
+			return opts, status.Error(codes.InvalidArgument, "maintenance window end must be after start")
+		}
+
+		opts.MaintenanceWindows = append(opts.MaintenanceWindows, fuota.MaintenanceWindow{
+			Start: start,
+			End:   end,
+		})
+	}
+
+	return opts, nil
+}
+
+// GetDeployment returns the FUOTA deployment options given an ID.
+func (a *FUOTAServerAPI) GetDeployment(ctx context.Context, req *fapi.GetDeploymentRequest) (*fapi.GetDeploymentResponse, error) {
+	id, err := uuid.FromString(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := storage.GetDeployment(ctx, storage.DB(), id)
 	if err != nil {
 		return nil, err
 	}
 
-	go func(depl *fuota.Deployment) {
-		if err := depl.Run(context.Background()); err != nil {
-			log.WithError(err).WithField("deployment_id", depl.GetID()).Error("api: fuota deployment error")
+	devices, err := storage.GetDeploymentDevices(ctx, storage.DB(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := fapi.GetDeploymentResponse{
+		Deployment: &fapi.Deployment{
+			ApplicationId:                     d.ApplicationID,
+			MulticastGroupType:                fapi.MulticastGroupType(fapi.MulticastGroupType_value[d.MulticastGroupType]),
+			MulticastDr:                       uint32(d.MulticastDR),
+			MulticastPingSlotPeriod:           uint32(d.MulticastPingSlotPeriodicity),
+			MulticastFrequency:                d.MulticastFrequency,
+			MulticastGroupId:                  uint32(d.MCGroupID),
+			MulticastTimeout:                  uint32(d.MulticastTimeout),
+			MulticastRegion:                   fapi.Region(common.Region_value[d.MulticastRegion]),
+			UnicastTimeout:                    ptypes.DurationProto(d.UnicastTimeout),
+			UnicastAttemptCount:               uint32(d.UnicastAttemptCount),
+			FragmentationFragmentSize:         uint32(d.FragSize),
+			FragmentationRedundancy:           uint32(d.Redundancy),
+			FragmentationSessionIndex:         uint32(d.FragmentationSessionIndex),
+			FragmentationMatrix:               uint32(d.FragmentationMatrix),
+			FragmentationBlockAckDelay:        uint32(d.BlockAckDelay),
+			FragmentationDescriptor:           d.Descriptor,
+			RequestFragmentationSessionStatus: fapi.RequestFragmentationSessionStatus(fapi.RequestFragmentationSessionStatus_value[d.RequestFragmentationSessionStatus]),
+			RequestPackageVersions:            d.RequestPackageVersions,
+			AutoMulticastGroupId:              d.AutoMCGroupID,
+			RepairAttemptCount:                uint32(d.RepairAttemptCount),
+			FragmentationPackageVersion:       uint32(d.FragmentationPackageVersion),
+			MulticastSetupPackageVersion:      uint32(d.MulticastSetupPackageVersion),
+			FirmwareManagement:                d.FirmwareManagement,
+			FirmwareVersion:                   d.FirmwareVersion,
+			RebootCountdown:                   ptypes.DurationProto(d.RebootCountdown),
+			Compression:                       fapi.PayloadCompression(fapi.PayloadCompression_value[d.Compression]),
+			SignPayload:                       d.PayloadSigned,
+			ChunkSize:                         uint32(d.ChunkSize),
+		},
+		PayloadSize:           uint32(d.PayloadSize),
+		PayloadSha256:         hex.EncodeToString(d.PayloadSHA256),
+		CompressedPayloadSize: uint32(d.CompressedPayloadSize),
+		ChunkCount:            uint32(d.ChunkCount),
+	}
+
+	if req.GetIncludePayload() {
+		resp.Deployment.Payload = d.Payload
+	}
+
+	if d.StartAt != nil {
+		resp.Deployment.StartAt, err = ptypes.TimestampProto(*d.StartAt)
+		if err != nil {
+			return nil, err
 		}
-	}(depl)
+	}
 
-	return &fapi.CreateDeploymentResponse{
-		Id: depl.GetID().String(),
-	}, nil
+	if d.FirmwareID != nil {
+		resp.Deployment.FirmwareId = d.FirmwareID.String()
+	}
+
+	if d.RebootAt != nil {
+		resp.Deployment.RebootAt, err = ptypes.TimestampProto(*d.RebootAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	windows, err := storage.GetDeploymentMaintenanceWindows(ctx, storage.DB(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, w := range windows {
+		var mw fapi.MaintenanceWindow
+
+		mw.Start, err = ptypes.TimestampProto(w.StartAt)
+		if err != nil {
+			return nil, err
+		}
+
+		mw.End, err = ptypes.TimestampProto(w.EndAt)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Deployment.MaintenanceWindows = append(resp.Deployment.MaintenanceWindows, &mw)
+	}
+
+	for _, device := range devices {
+		resp.Deployment.Devices = append(resp.Deployment.Devices, &fapi.DeploymentDevice{
+			DevEui: device.DevEUI.String(),
+		})
+	}
+
+	resp.CreatedAt, err = ptypes.TimestampProto(d.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
 }
 
 // GetDeploymentStatus returns the FUOTA deployment status given an ID.
@@ -105,7 +423,12 @@ func (a *FUOTAServerAPI) GetDeploymentStatus(ctx context.Context, req *fapi.GetD
 		return nil, err
 	}
 
-	var resp fapi.GetDeploymentStatusResponse
+	resp := fapi.GetDeploymentStatusResponse{
+		State:           fapi.DeploymentState(fapi.DeploymentState_value[string(d.State)]),
+		ErrorMessage:    d.ErrorMessage,
+		ChunkCount:      uint32(d.ChunkCount),
+		ChunksCompleted: uint32(d.ChunksCompleted),
+	}
 
 	resp.CreatedAt, err = ptypes.TimestampProto(d.CreatedAt)
 	if err != nil {
@@ -152,6 +475,66 @@ func (a *FUOTAServerAPI) GetDeploymentStatus(ctx context.Context, req *fapi.GetD
 		}
 	}
 
+	if d.DeviceCleanupCompletedAt != nil {
+		resp.DeviceCleanupCompletedAt, err = ptypes.TimestampProto(*d.DeviceCleanupCompletedAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if d.PackageVersionCompletedAt != nil {
+		resp.PackageVersionCompletedAt, err = ptypes.TimestampProto(*d.PackageVersionCompletedAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if d.FragRepairCompletedAt != nil {
+		resp.FragRepairCompletedAt, err = ptypes.TimestampProto(*d.FragRepairCompletedAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if d.DevVersionCompletedAt != nil {
+		resp.DevVersionCompletedAt, err = ptypes.TimestampProto(*d.DevVersionCompletedAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if d.UpgradeImageCompletedAt != nil {
+		resp.UpgradeImageCompletedAt, err = ptypes.TimestampProto(*d.UpgradeImageCompletedAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if d.RebootCompletedAt != nil {
+		resp.RebootCompletedAt, err = ptypes.TimestampProto(*d.RebootCompletedAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if d.FWVersionCompletedAt != nil {
+		resp.FwVersionCompletedAt, err = ptypes.TimestampProto(*d.FWVersionCompletedAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if d.ParentDeploymentID != nil {
+		resp.ParentDeploymentId = d.ParentDeploymentID.String()
+	}
+
+	if d.CancelledAt != nil {
+		resp.CancelledAt, err = ptypes.TimestampProto(*d.CancelledAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	devices, err := storage.GetDeploymentDevices(ctx, storage.DB(), id)
 	if err != nil {
 		return nil, err
@@ -159,7 +542,10 @@ func (a *FUOTAServerAPI) GetDeploymentStatus(ctx context.Context, req *fapi.GetD
 
 	for _, device := range devices {
 		dd := fapi.DeploymentDeviceStatus{
-			DevEui: device.DevEUI.String(),
+			DevEui:          device.DevEUI.String(),
+			State:           fapi.DeploymentDeviceState(fapi.DeploymentDeviceState_value["DEVICE_"+string(device.State)]),
+			FailureReason:   fapi.DeploymentDeviceFailureReason(fapi.DeploymentDeviceFailureReason_value[string(device.FailureReason)]),
+			ChunksCompleted: uint32(device.ChunksCompleted),
 		}
 		var err error
 
@@ -201,12 +587,97 @@ func (a *FUOTAServerAPI) GetDeploymentStatus(ctx context.Context, req *fapi.GetD
 			}
 		}
 
+		if device.PackageVersionCompletedAt != nil {
+			dd.PackageVersionCompletedAt, err = ptypes.TimestampProto(*device.PackageVersionCompletedAt)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if device.FragDataBlockReceivedAt != nil {
+			dd.FragDataBlockReceivedAt, err = ptypes.TimestampProto(*device.FragDataBlockReceivedAt)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if device.HWVersion != nil {
+			dd.HwVersion = *device.HWVersion
+		}
+
+		if device.FWVersionBefore != nil {
+			dd.FwVersionBefore = *device.FWVersionBefore
+		}
+
+		if device.FWVersionNext != nil {
+			dd.FwVersionNext = *device.FWVersionNext
+		}
+
+		if device.FWVersionAfter != nil {
+			dd.FwVersionAfter = *device.FWVersionAfter
+		}
+
+		if device.UpgradeImageCompletedAt != nil {
+			dd.UpgradeImageCompletedAt, err = ptypes.TimestampProto(*device.UpgradeImageCompletedAt)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if device.RebootCompletedAt != nil {
+			dd.RebootCompletedAt, err = ptypes.TimestampProto(*device.RebootCompletedAt)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if device.FWVersionCompletedAt != nil {
+			dd.FwVersionCompletedAt, err = ptypes.TimestampProto(*device.FWVersionCompletedAt)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		resp.DeviceStatus = append(resp.DeviceStatus, &dd)
 	}
 
 	return &resp, nil
 }
 
+// CancelDeployment cancels the FUOTA deployment given an ID.
+func (a *FUOTAServerAPI) CancelDeployment(ctx context.Context, req *fapi.CancelDeploymentRequest) (*emptypb.Empty, error) {
+	id, err := uuid.FromString(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := fuota.CancelDeployment(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// RetryDeployment creates and starts a new FUOTA deployment for the devices
+// that did not complete the given step.
+func (a *FUOTAServerAPI) RetryDeployment(ctx context.Context, req *fapi.RetryDeploymentRequest) (*fapi.RetryDeploymentResponse, error) {
+	id, err := uuid.FromString(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	depl, err := fuota.RetryDeployment(ctx, id, fuota.RetryStep(req.GetStep().String()))
+	if err != nil {
+		return nil, err
+	}
+
+	fuota.Start(depl)
+
+	return &fapi.RetryDeploymentResponse{
+		Id: depl.GetID().String(),
+	}, nil
+}
+
 // GetDeploymentDeviceLogs returns the FUOTA logs given a deployment ID and DevEUI.
 func (a *FUOTAServerAPI) GetDeploymentDeviceLogs(ctx context.Context, req *fapi.GetDeploymentDeviceLogsRequest) (*fapi.GetDeploymentDeviceLogsResponse, error) {
 	var devEUI lorawan.EUI64
@@ -247,3 +718,464 @@ func (a *FUOTAServerAPI) GetDeploymentDeviceLogs(ctx context.Context, req *fapi.
 
 	return &resp, nil
 }
+
+// GetDeploymentDeviceMulticastGroups returns the multicast-groups reported as
+// active by the device given a deployment ID and DevEUI.
+func (a *FUOTAServerAPI) GetDeploymentDeviceMulticastGroups(ctx context.Context, req *fapi.GetDeploymentDeviceMulticastGroupsRequest) (*fapi.GetDeploymentDeviceMulticastGroupsResponse, error) {
+	var devEUI lorawan.EUI64
+	var resp fapi.GetDeploymentDeviceMulticastGroupsResponse
+
+	deploymentID, err := uuid.FromString(req.GetDeploymentId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := devEUI.UnmarshalText([]byte(req.GetDevEui())); err != nil {
+		return nil, err
+	}
+
+	groups, err := storage.GetDeploymentDeviceMCGroups(ctx, storage.DB(), deploymentID, devEUI)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, g := range groups {
+		mg := fapi.DeploymentDeviceMulticastGroup{
+			MulticastGroupId: uint32(g.MCGroupID),
+			McAddr:           g.MCAddr.String(),
+		}
+
+		mg.CreatedAt, err = ptypes.TimestampProto(g.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.MulticastGroups = append(resp.MulticastGroups, &mg)
+	}
+
+	return &resp, nil
+}
+
+// ListDeployments returns the FUOTA deployments matching the given filters.
+func (a *FUOTAServerAPI) ListDeployments(ctx context.Context, req *fapi.ListDeploymentsRequest) (*fapi.ListDeploymentsResponse, error) {
+	filters := storage.DeploymentFilters{
+		ApplicationID: req.GetApplicationId(),
+		Limit:         int(req.GetLimit()),
+		Offset:        int(req.GetOffset()),
+	}
+
+	for _, s := range req.GetStates() {
+		filters.States = append(filters.States, storage.DeploymentState(s.String()))
+	}
+
+	if req.GetCreatedAfter() != nil {
+		createdAfter, err := ptypes.Timestamp(req.GetCreatedAfter())
+		if err != nil {
+			return nil, err
+		}
+		filters.CreatedAfter = &createdAfter
+	}
+
+	if req.GetCreatedBefore() != nil {
+		createdBefore, err := ptypes.Timestamp(req.GetCreatedBefore())
+		if err != nil {
+			return nil, err
+		}
+		filters.CreatedBefore = &createdBefore
+	}
+
+	if req.GetDevEui() != "" {
+		var devEUI lorawan.EUI64
+		if err := devEUI.UnmarshalText([]byte(req.GetDevEui())); err != nil {
+			return nil, err
+		}
+		filters.DevEUI = &devEUI
+	}
+
+	count, err := storage.GetDeploymentCount(ctx, storage.DB(), filters)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := storage.GetDeployments(ctx, storage.DB(), filters)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := fapi.ListDeploymentsResponse{
+		TotalCount: uint32(count),
+	}
+
+	for _, item := range items {
+		d := fapi.DeploymentListItem{
+			Id:            item.ID.String(),
+			ApplicationId: item.ApplicationID,
+			State:         fapi.DeploymentState(fapi.DeploymentState_value[string(item.State)]),
+		}
+
+		d.CreatedAt, err = ptypes.TimestampProto(item.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		d.UpdatedAt, err = ptypes.TimestampProto(item.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Result = append(resp.Result, &d)
+	}
+
+	return &resp, nil
+}
+
+// WatchDeployment streams the events of the FUOTA deployment given an ID.
+func (a *FUOTAServerAPI) WatchDeployment(req *fapi.WatchDeploymentRequest, srv fapi.FuotaServerService_WatchDeploymentServer) error {
+	id, err := uuid.FromString(req.GetId())
+	if err != nil {
+		return err
+	}
+
+	// subscribe before reading the deployment state so that the end of the
+	// deployment can't be missed
+	events, unsubscribe := fuota.Subscribe(id)
+	defer unsubscribe()
+
+	d, err := storage.GetDeployment(srv.Context(), storage.DB(), id)
+	if err != nil {
+		return err
+	}
+
+	switch d.State {
+	case storage.DeploymentStatePending, storage.DeploymentStateRunning:
+	default:
+		return srv.Send(&fapi.DeploymentEvent{
+			Type:         fapi.DeploymentEventType_DEPLOYMENT_ENDED,
+			Time:         ptypes.TimestampNow(),
+			State:        fapi.DeploymentState(fapi.DeploymentState_value[string(d.State)]),
+			ErrorMessage: d.ErrorMessage,
+		})
+	}
+
+	for {
+		select {
+		case <-srv.Context().Done():
+			return nil
+		case e := <-events:
+			de := fapi.DeploymentEvent{
+				Type:         fapi.DeploymentEventType(fapi.DeploymentEventType_value[string(e.Type)]),
+				Step:         e.Step,
+				State:        fapi.DeploymentState(fapi.DeploymentState_value[string(e.State)]),
+				ErrorMessage: e.ErrorMessage,
+			}
+
+			de.Time, err = ptypes.TimestampProto(e.Time)
+			if err != nil {
+				return err
+			}
+
+			if e.Log != nil {
+				de.DevEui = e.Log.DevEUI.String()
+				de.Log = &fapi.DeploymentDeviceLog{
+					FPort:   uint32(e.Log.FPort),
+					Command: e.Log.Command,
+					Fields:  make(map[string]string),
+				}
+
+				de.Log.CreatedAt, err = ptypes.TimestampProto(e.Log.CreatedAt)
+				if err != nil {
+					return err
+				}
+
+				for k, v := range e.Log.Fields.Map {
+					de.Log.Fields[k] = v.String
+				}
+			}
+
+			if err := srv.Send(&de); err != nil {
+				return err
+			}
+
+			if e.Type == fuota.EventTypeDeploymentEnded {
+				return nil
+			}
+		}
+	}
+}
+
+// CreateCampaign creates and starts the given staged rollout.
+func (a *FUOTAServerAPI) CreateCampaign(ctx context.Context, req *fapi.CreateCampaignRequest) (*fapi.CreateCampaignResponse, error) {
+	deplOpts, err := deploymentOptions(ctx, req.GetCampaign().GetDeployment())
+	if err != nil {
+		return nil, err
+	}
+
+	opts := fuota.CampaignOptions{
+		Deployment:       deplOpts,
+		SuccessThreshold: float64(req.GetCampaign().SuccessThreshold),
+	}
+
+	for _, p := range req.GetCampaign().WavePercentages {
+		opts.WavePercentages = append(opts.WavePercentages, float64(p))
+	}
+
+	id, err := fuota.NewCampaign(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	fuota.StartCampaign(id)
+
+	return &fapi.CreateCampaignResponse{
+		Id: id.String(),
+	}, nil
+}
+
+// GetCampaignStatus returns the campaign status given an ID.
+func (a *FUOTAServerAPI) GetCampaignStatus(ctx context.Context, req *fapi.GetCampaignStatusRequest) (*fapi.GetCampaignStatusResponse, error) {
+	id, err := uuid.FromString(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := storage.GetCampaign(ctx, storage.DB(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	waves, err := storage.GetCampaignWaves(ctx, storage.DB(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := fapi.GetCampaignStatusResponse{
+		State:            fapi.CampaignState(fapi.CampaignState_value["CAMPAIGN_"+string(c.State)]),
+		ErrorMessage:     c.ErrorMessage,
+		SuccessThreshold: float32(c.SuccessThreshold),
+	}
+
+	resp.CreatedAt, err = ptypes.TimestampProto(c.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.UpdatedAt, err = ptypes.TimestampProto(c.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.CompletedAt != nil {
+		resp.CompletedAt, err = ptypes.TimestampProto(*c.CompletedAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, w := range waves {
+		var rate float32
+		if w.DeviceCount != 0 {
+			rate = float32(w.FragStatusSuccess) / float32(w.DeviceCount) * 100
+		}
+
+		resp.Waves = append(resp.Waves, &fapi.CampaignWaveStatus{
+			Wave:         uint32(w.Wave),
+			DeploymentId: w.DeploymentID.String(),
+			State:        fapi.DeploymentState(fapi.DeploymentState_value[string(w.State)]),
+			DeviceCount:  uint32(w.DeviceCount),
+			SuccessCount: uint32(w.FragStatusSuccess),
+			SuccessRate:  rate,
+		})
+	}
+
+	return &resp, nil
+}
+
+// UploadFirmware uploads a firmware image.
+func (a *FUOTAServerAPI) UploadFirmware(srv fapi.FuotaServerService_UploadFirmwareServer) error {
+	req, err := srv.Recv()
+	if err != nil {
+		return err
+	}
+
+	fw := req.GetFirmware()
+	if fw == nil {
+		return status.Error(codes.InvalidArgument, "the first message must contain the firmware")
+	}
+	if fw.GetVersion() == "" || fw.GetHardware() == "" {
+		return status.Error(codes.InvalidArgument, "version and hardware must be set")
+	}
+
+	var payload []byte
+	for {
+		req, err := srv.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if req.GetFirmware() != nil {
+			return status.Error(codes.InvalidArgument, "the firmware must only be sent in the first message")
+		}
+		if a.maxFirmwareSize != 0 && len(payload)+len(req.GetChunk()) > a.maxFirmwareSize {
+			return status.Errorf(codes.InvalidArgument, "the firmware image exceeds the max. size of %d bytes", a.maxFirmwareSize)
+		}
+		payload = append(payload, req.GetChunk()...)
+	}
+
+	if len(payload) == 0 {
+		return status.Error(codes.InvalidArgument, "the firmware image must not be empty")
+	}
+
+	var signature []byte
+	if fw.GetSignature() != "" {
+		signature, err = hex.DecodeString(fw.GetSignature())
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "signature: %s", err)
+		}
+	}
+
+	if len(signature) != 0 || signing.HasTrustedKeys() {
+		if len(signature) == 0 {
+			return status.Error(codes.InvalidArgument, "the firmware signature is required")
+		}
+		if err := signing.Verify(payload, signature); err != nil {
+			return status.Errorf(codes.InvalidArgument, "verify firmware signature error: %s", err)
+		}
+	}
+
+	payloadHash := sha256.Sum256(payload)
+	if fw.GetSha256() != "" {
+		expHash, err := hex.DecodeString(fw.GetSha256())
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "sha256: %s", err)
+		}
+		if !bytes.Equal(expHash, payloadHash[:]) {
+			return status.Error(codes.InvalidArgument, "sha256 of the firmware image does not match")
+		}
+	}
+
+	f := storage.Firmware{
+		Version:       fw.GetVersion(),
+		Hardware:      fw.GetHardware(),
+		Payload:       payload,
+		PayloadSize:   len(payload),
+		PayloadSHA256: payloadHash[:],
+		Signature:     signature,
+		Metadata: hstore.Hstore{
+			Map: make(map[string]sql.NullString),
+		},
+	}
+	for k, v := range fw.GetMetadata() {
+		f.Metadata.Map[k] = sql.NullString{Valid: true, String: v}
+	}
+
+	if err := storage.CreateFirmware(srv.Context(), storage.DB(), &f); err != nil {
+		return err
+	}
+
+	return srv.SendAndClose(&fapi.UploadFirmwareResponse{
+		Id: f.ID.String(),
+	})
+}
+
+// GetFirmware returns the firmware given an ID.
+func (a *FUOTAServerAPI) GetFirmware(ctx context.Context, req *fapi.GetFirmwareRequest) (*fapi.GetFirmwareResponse, error) {
+	id, err := uuid.FromString(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := storage.GetFirmware(ctx, storage.DB(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := fapi.GetFirmwareResponse{
+		Firmware: &fapi.Firmware{
+			Version:   f.Version,
+			Hardware:  f.Hardware,
+			Sha256:    hex.EncodeToString(f.PayloadSHA256),
+			Metadata:  make(map[string]string),
+			Signature: hex.EncodeToString(f.Signature),
+		},
+		PayloadSize: uint32(f.PayloadSize),
+	}
+
+	for k, v := range f.Metadata.Map {
+		resp.Firmware.Metadata[k] = v.String
+	}
+
+	if req.GetIncludePayload() {
+		resp.Payload = f.Payload
+	}
+
+	resp.CreatedAt, err = ptypes.TimestampProto(f.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// ListFirmware returns the firmware images matching the given filters.
+func (a *FUOTAServerAPI) ListFirmware(ctx context.Context, req *fapi.ListFirmwareRequest) (*fapi.ListFirmwareResponse, error) {
+	filters := storage.FirmwareFilters{
+		Hardware: req.GetHardware(),
+		Limit:    int(req.GetLimit()),
+		Offset:   int(req.GetOffset()),
+	}
+
+	count, err := storage.GetFirmwareCount(ctx, storage.DB(), filters)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := storage.GetFirmwares(ctx, storage.DB(), filters)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := fapi.ListFirmwareResponse{
+		TotalCount: uint32(count),
+	}
+
+	for _, item := range items {
+		f := fapi.FirmwareListItem{
+			Id:          item.ID.String(),
+			Version:     item.Version,
+			Hardware:    item.Hardware,
+			PayloadSize: uint32(item.PayloadSize),
+			Sha256:      hex.EncodeToString(item.PayloadSHA256),
+		}
+
+		f.CreatedAt, err = ptypes.TimestampProto(item.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		f.UpdatedAt, err = ptypes.TimestampProto(item.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Result = append(resp.Result, &f)
+	}
+
+	return &resp, nil
+}
+
+// DeleteFirmware deletes the firmware given an ID.
+func (a *FUOTAServerAPI) DeleteFirmware(ctx context.Context, req *fapi.DeleteFirmwareRequest) (*emptypb.Empty, error) {
+	id, err := uuid.FromString(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := storage.DeleteFirmware(ctx, storage.DB(), id); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
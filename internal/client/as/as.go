@@ -19,6 +19,7 @@ var (
 	applicationClient    api.ApplicationServiceClient
 	multicastGroupClient api.MulticastGroupServiceClient
 	deviceClient         api.DeviceServiceClient
+	deviceProfileClient  api.DeviceProfileServiceClient
 )
 
 type APIToken string
@@ -67,6 +68,7 @@ func Setup(conf *config.Config) error {
 	applicationClient = api.NewApplicationServiceClient(clientConn)
 	multicastGroupClient = api.NewMulticastGroupServiceClient(clientConn)
 	deviceClient = api.NewDeviceServiceClient(clientConn)
+	deviceProfileClient = api.NewDeviceProfileServiceClient(clientConn)
 
 	return nil
 }
@@ -79,6 +81,10 @@ func MulticastGroupClient() api.MulticastGroupServiceClient {
 	return multicastGroupClient
 }
 
+func SetMulticastGroupClient(c api.MulticastGroupServiceClient) {
+	multicastGroupClient = c
+}
+
 func DeviceClient() api.DeviceServiceClient {
 	return deviceClient
 }
@@ -86,3 +92,11 @@ func DeviceClient() api.DeviceServiceClient {
 func SetDeviceClient(c api.DeviceServiceClient) {
 	deviceClient = c
 }
+
+func DeviceProfileClient() api.DeviceProfileServiceClient {
+	return deviceProfileClient
+}
+
+func SetDeviceProfileClient(c api.DeviceProfileServiceClient) {
+	deviceProfileClient = c
+}
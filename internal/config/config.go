@@ -1,5 +1,7 @@
 package config
 
+import "time"
+
 // Version defines the ChirpStack FUOTA Server version.
 var Version string
 
@@ -16,6 +18,7 @@ type Config struct {
 		Automigrate        bool   `mapstructure:"automigrate"`
 		MaxOpenConnections int    `mapstructure:"max_open_connections"`
 		MaxIdleConnections int    `mapstructure:"max_idle_connections"`
+		EncryptionKey      string `mapstructure:"encryption_key"`
 	} `mapstructure:"postgresql"`
 
 	ChirpStack struct {
@@ -39,7 +42,18 @@ type Config struct {
 			CACert  string `mapstructure:"ca_cert"`
 			TLSCert string `mapstructure:"tls_cert"`
 			TLSKey  string `mapstructure:"tls_key"`
+
+			MaxFirmwareSize int `mapstructure:"max_firmware_size"`
 		} `mapstructure:"api"`
+
+		Scheduler struct {
+			Interval time.Duration `mapstructure:"interval"`
+		} `mapstructure:"scheduler"`
+
+		Signing struct {
+			KeyFile         string   `mapstructure:"key_file"`
+			TrustedKeyFiles []string `mapstructure:"trusted_key_files"`
+		} `mapstructure:"signing"`
 	} `mapstructure:"fuota_server"`
 }
 
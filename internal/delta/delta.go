@@ -0,0 +1,335 @@
+// Package delta implements the generation of binary diffs between firmware
+// images, so that only the difference between the firmware installed on the
+// devices (base) and the new firmware (target) needs to be transferred. The
+// diff uses the JojoDiff format, which can be applied on the device using
+// janpatch.
+package delta
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+)
+
+// JojoDiff operations. Each operation is prefixed by the escape byte.
+const (
+	opESC = 0xa7 // escape
+	opMOD = 0xa6 // modify (overwrite) the source bytes
+	opINS = 0xa5 // insert bytes
+	opDEL = 0xa4 // skip source bytes
+	opEQL = 0xa3 // copy source bytes
+	opBKT = 0xa2 // move the source position backwards
+)
+
+// DescriptorFormatJanpatch defines the fragmentation descriptor format for a
+// JojoDiff (janpatch) delta.
+const DescriptorFormatJanpatch = 0x01
+
+const (
+	// blockSize defines the number of bytes used for finding match
+	// candidates in the base image.
+	blockSize = 8
+
+	// minMatch defines the min. number of matching bytes to encode a copy
+	// from the base image instead of the target bytes.
+	minMatch = 12
+
+	// maxCandidates defines the max. number of match candidates to evaluate
+	// per position.
+	maxCandidates = 16
+)
+
+// ErrInvalidPatch is returned when the patch can not be applied to the base
+// image.
+var ErrInvalidPatch = errors.New("invalid patch")
+
+// Descriptor returns the fragmentation-session descriptor signalling a delta
+// payload. The 4 least significant bits of the first byte contain the delta
+// format, the remaining bytes contain the first 3 bytes of the SHA-256 hash of
+// the base image. This allows the device to verify that the patch applies to
+// the installed firmware before accepting the fragmentation-session.
+func Descriptor(base []byte) [4]byte {
+	hash := sha256.Sum256(base)
+	return [4]byte{DescriptorFormatJanpatch, hash[0], hash[1], hash[2]}
+}
+
+// Diff returns the patch which transforms the base image into the target
+// image.
+func Diff(base, target []byte) []byte {
+	idx := newIndex(base)
+	w := patchWriter{}
+
+	src := 0   // source position of the patch
+	start := 0 // start of the target bytes that are not yet written
+	for i := 0; i < len(target); {
+		pos, n := idx.match(src, target[i:])
+		if n < minMatch {
+			i++
+			continue
+		}
+
+		src = w.writeData(target[start:i], src, pos)
+		w.writeSeek(src, pos)
+		w.writeLength(opEQL, n)
+
+		src = pos + n
+		i += n
+		start = i
+	}
+	w.writeData(target[start:], src, -1)
+
+	return w.b
+}
+
+// Patch applies the given patch to the base image and returns the target
+// image.
+func Patch(base, patch []byte) ([]byte, error) {
+	r := patchReader{b: patch}
+	var out []byte
+	src := 0
+
+	for !r.eof() {
+		op := byte(opMOD)
+		if r.peek() == opESC {
+			r.next()
+			if r.eof() {
+				return nil, ErrInvalidPatch
+			}
+			op = r.next()
+		}
+
+		switch op {
+		case opMOD, opINS:
+			data, err := r.readData()
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, data...)
+			if op == opMOD {
+				src += len(data)
+			}
+		case opEQL, opDEL, opBKT:
+			n, err := r.readLength()
+			if err != nil {
+				return nil, err
+			}
+
+			switch op {
+			case opEQL:
+				if src < 0 || src+n > len(base) {
+					return nil, ErrInvalidPatch
+				}
+				out = append(out, base[src:src+n]...)
+				src += n
+			case opDEL:
+				src += n
+			case opBKT:
+				src -= n
+			}
+		default:
+			return nil, ErrInvalidPatch
+		}
+	}
+
+	return out, nil
+}
+
+// index provides the match candidates in the base image using hash chains.
+type index struct {
+	base []byte
+	head map[uint64]int
+	prev []int
+}
+
+func newIndex(base []byte) *index {
+	idx := index{
+		base: base,
+		head: make(map[uint64]int),
+		prev: make([]int, len(base)),
+	}
+
+	// iterate backwards so that the chains are in ascending order
+	for i := len(base) - blockSize; i >= 0; i-- {
+		k := binary.LittleEndian.Uint64(base[i:])
+		if p, ok := idx.head[k]; ok {
+			idx.prev[i] = p
+		} else {
+			idx.prev[i] = -1
+		}
+		idx.head[k] = i
+	}
+
+	return &idx
+}
+
+// match returns the position and length of the longest match of the given
+// bytes in the base image. The current source position is preferred as this
+// does not require a seek.
+func (idx *index) match(src int, b []byte) (int, int) {
+	pos, n := src, matchLength(idx.base, src, b)
+	if len(b) < blockSize {
+		return pos, n
+	}
+
+	p, ok := idx.head[binary.LittleEndian.Uint64(b)]
+	for c := 0; ok && p != -1 && c < maxCandidates; c++ {
+		if l := matchLength(idx.base, p, b); l > n {
+			pos, n = p, l
+		}
+		p = idx.prev[p]
+	}
+
+	return pos, n
+}
+
+// matchLength returns the number of bytes of b matching the base image at
+// the given position.
+func matchLength(base []byte, pos int, b []byte) int {
+	if pos < 0 || pos >= len(base) {
+		return 0
+	}
+
+	n := 0
+	for n < len(b) && pos+n < len(base) && base[pos+n] == b[n] {
+		n++
+	}
+	return n
+}
+
+type patchWriter struct {
+	b []byte
+}
+
+// writeData writes the given target bytes and returns the new source
+// position. When the bytes fit in the gap between the source position and
+// the next match position, these are written as a modification as this
+// moves the source position along. A next position of -1 indicates the end
+// of the patch.
+func (w *patchWriter) writeData(data []byte, src, next int) int {
+	if len(data) == 0 {
+		return src
+	}
+
+	op := byte(opINS)
+	if next != -1 && next-src >= len(data) {
+		op = opMOD
+		src += len(data)
+	}
+
+	w.b = append(w.b, opESC, op)
+	for _, b := range data {
+		if b == opESC {
+			w.b = append(w.b, opESC)
+		}
+		w.b = append(w.b, b)
+	}
+
+	return src
+}
+
+// writeSeek writes the operation moving the source position from src to pos.
+func (w *patchWriter) writeSeek(src, pos int) {
+	switch {
+	case pos > src:
+		w.writeLength(opDEL, pos-src)
+	case pos < src:
+		w.writeLength(opBKT, src-pos)
+	}
+}
+
+// writeLength writes the given operation and length (> 0).
+func (w *patchWriter) writeLength(op byte, n int) {
+	w.b = append(w.b, opESC, op)
+
+	switch {
+	case n <= 252:
+		w.b = append(w.b, byte(n-1))
+	case n <= 508:
+		w.b = append(w.b, 252, byte(n-253))
+	case n <= 0xffff:
+		w.b = append(w.b, 253, byte(n>>8), byte(n))
+	default:
+		w.b = append(w.b, 254, byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+	}
+}
+
+type patchReader struct {
+	b   []byte
+	pos int
+}
+
+func (r *patchReader) eof() bool {
+	return r.pos >= len(r.b)
+}
+
+func (r *patchReader) peek() byte {
+	return r.b[r.pos]
+}
+
+func (r *patchReader) next() byte {
+	b := r.b[r.pos]
+	r.pos++
+	return b
+}
+
+// readData reads the data bytes until the next operation or the end of the
+// patch.
+func (r *patchReader) readData() ([]byte, error) {
+	var out []byte
+
+	for !r.eof() {
+		if r.peek() != opESC {
+			out = append(out, r.next())
+			continue
+		}
+
+		if r.pos+1 >= len(r.b) {
+			return nil, ErrInvalidPatch
+		}
+
+		switch b := r.b[r.pos+1]; {
+		case b == opESC:
+			out = append(out, opESC)
+			r.pos += 2
+		case b >= opBKT && b <= opMOD:
+			return out, nil
+		default:
+			out = append(out, opESC, b)
+			r.pos += 2
+		}
+	}
+
+	return out, nil
+}
+
+// readLength reads the length of an EQL, DEL or BKT operation.
+func (r *patchReader) readLength() (int, error) {
+	if r.eof() {
+		return 0, ErrInvalidPatch
+	}
+
+	var size, offset int
+	switch l := r.next(); l {
+	case 252:
+		size, offset = 1, 253
+	case 253:
+		size = 2
+	case 254:
+		size = 4
+	case 255:
+		return 0, ErrInvalidPatch
+	default:
+		return int(l) + 1, nil
+	}
+
+	if r.pos+size > len(r.b) {
+		return 0, ErrInvalidPatch
+	}
+
+	n := 0
+	for i := 0; i < size; i++ {
+		n = n<<8 | int(r.next())
+	}
+
+	return n + offset, nil
+}
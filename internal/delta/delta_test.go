@@ -0,0 +1,125 @@
+package delta
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescriptor(t *testing.T) {
+	assert := require.New(t)
+	// sha256("hello world") = b94d27b9...
+	assert.Equal([4]byte{0x01, 0xb9, 0x4d, 0x27}, Descriptor([]byte("hello world")))
+}
+
+func TestDiff(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	random := func(n int) []byte {
+		b := make([]byte, n)
+		rnd.Read(b)
+		return b
+	}
+	concat := func(bb ...[]byte) []byte {
+		var out []byte
+		for _, b := range bb {
+			out = append(out, b...)
+		}
+		return out
+	}
+
+	base := random(100000)
+
+	modified := concat(base)
+	for i := 0; i < 20; i++ {
+		modified[rnd.Intn(len(modified))] ^= 0xff
+	}
+
+	tests := []struct {
+		name    string
+		base    []byte
+		target  []byte
+		maxSize int
+	}{
+		{"identical", base, base, 8},
+		{"no base", nil, []byte{1, 2, 3}, 5},
+		{"modified bytes", base, modified, 300},
+		{"inserted bytes", base, concat(base[:500], random(100), base[500:]), 150},
+		{"removed bytes", base, concat(base[:500], base[600:]), 20},
+		{"moved block", base, concat(base[50000:], base[:50000]), 30},
+		{"escape bytes", base, concat(base[:10], []byte{0xa7, 0xa7, 0xa3, 0xa7}, base[10:]), 30},
+		{"empty target", base, nil, 0},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.name, func(t *testing.T) {
+			assert := require.New(t)
+
+			patch := Diff(tst.base, tst.target)
+			assert.LessOrEqual(len(patch), tst.maxSize)
+
+			out, err := Patch(tst.base, patch)
+			assert.NoError(err)
+			assert.Equal(tst.target, out)
+		})
+	}
+}
+
+func TestPatch(t *testing.T) {
+	base := []byte{1, 2, 3, 4, 5, 6}
+
+	tests := []struct {
+		name   string
+		patch  []byte
+		target []byte
+		err    error
+	}{
+		{"equal", []byte{0xa7, 0xa3, 0x05}, base, nil},
+		{"modify", []byte{0xa7, 0xa6, 0x09, 0xa7, 0xa3, 0x04}, []byte{9, 2, 3, 4, 5, 6}, nil},
+		{"insert", []byte{0xa7, 0xa5, 0x09, 0xa7, 0xa7, 0xa7, 0xa3, 0x05}, []byte{9, 0xa7, 1, 2, 3, 4, 5, 6}, nil},
+		{"delete", []byte{0xa7, 0xa4, 0x03, 0xa7, 0xa3, 0x01}, []byte{5, 6}, nil},
+		{"backtrack", []byte{0xa7, 0xa3, 0x05, 0xa7, 0xa2, 0x01, 0xa7, 0xa3, 0x01}, []byte{1, 2, 3, 4, 5, 6, 5, 6}, nil},
+		{"default modify", []byte{0x09, 0xa7, 0xa3, 0x04}, []byte{9, 2, 3, 4, 5, 6}, nil},
+		{"two-byte length", []byte{0xa7, 0xa3, 0xfd, 0x00, 0x06}, base, nil},
+		{"out of range", []byte{0xa7, 0xa3, 0x06}, nil, ErrInvalidPatch},
+		{"truncated", []byte{0xa7, 0xa3}, nil, ErrInvalidPatch},
+		{"invalid operation", []byte{0xa7, 0x01}, nil, ErrInvalidPatch},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.name, func(t *testing.T) {
+			assert := require.New(t)
+
+			out, err := Patch(base, tst.patch)
+			assert.Equal(tst.err, err)
+			assert.Equal(tst.target, out)
+		})
+	}
+}
+
+func TestWriteLength(t *testing.T) {
+	tests := []struct {
+		length int
+		bytes  []byte
+	}{
+		{1, []byte{0xa7, 0xa3, 0x00}},
+		{252, []byte{0xa7, 0xa3, 0xfb}},
+		{253, []byte{0xa7, 0xa3, 0xfc, 0x00}},
+		{508, []byte{0xa7, 0xa3, 0xfc, 0xff}},
+		{509, []byte{0xa7, 0xa3, 0xfd, 0x01, 0xfd}},
+		{65536, []byte{0xa7, 0xa3, 0xfe, 0x00, 0x01, 0x00, 0x00}},
+	}
+
+	for _, tst := range tests {
+		assert := require.New(t)
+
+		w := patchWriter{}
+		w.writeLength(opEQL, tst.length)
+		assert.Equal(tst.bytes, w.b)
+
+		r := patchReader{b: w.b[2:]}
+		n, err := r.readLength()
+		assert.NoError(err)
+		assert.Equal(tst.length, n)
+	}
+}
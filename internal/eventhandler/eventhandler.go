@@ -147,16 +147,17 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				log.WithError(err).Error("handle clocksync error")
 			}
 		}(uplinkEvent)
+	}
 
-	} else {
-		for id := range h.eventHandlers {
-			f := h.eventHandlers[id]
-			go func(pl integration.UplinkEvent) {
-				if err := f(context.Background(), pl); err != nil {
-					log.WithError(err).WithField("id", id).Error("integration/eventhandler: uplink event handler error")
-				}
-			}(uplinkEvent)
-		}
+	// The clocksync port is also forwarded as the deployments handle the
+	// PackageVersionAns of the clocksync package.
+	for id := range h.eventHandlers {
+		f := h.eventHandlers[id]
+		go func(pl integration.UplinkEvent) {
+			if err := f(context.Background(), pl); err != nil {
+				log.WithError(err).WithField("id", id).Error("integration/eventhandler: uplink event handler error")
+			}
+		}(uplinkEvent)
 	}
 
 }
@@ -2,11 +2,13 @@ package eventhandler
 
 import (
 	"bytes"
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/golang/mock/gomock"
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
@@ -95,4 +97,47 @@ func TestEventHandler(t *testing.T) {
 		assert.Equal(200, resp.StatusCode)
 		time.Sleep(time.Millisecond * 50) // request is handled async
 	})
+
+	t.Run("ClockSync PackageVersionAns is forwarded", func(t *testing.T) {
+		assert := require.New(t)
+
+		cmd := clocksync.Command{
+			CID: clocksync.PackageVersionAns,
+			Payload: &clocksync.PackageVersionAnsPayload{
+				PackageIdentifier: 1,
+				PackageVersion:    1,
+			},
+		}
+		cmdB, err := cmd.MarshalBinary()
+		assert.NoError(err)
+
+		received := make(chan []byte, 1)
+		id := uuid.Must(uuid.NewV4())
+		handler.RegisterUplinkEventFunc(id, func(ctx context.Context, pl integration.UplinkEvent) error {
+			received <- pl.Data
+			return nil
+		})
+		defer handler.UnregisterUplinkEventFunc(id)
+
+		uplink := integration.UplinkEvent{
+			DeviceInfo: &integration.DeviceInfo{
+				DevEui: "0102030405060708",
+			},
+			Data:  cmdB,
+			FPort: uint32(clocksync.DefaultFPort),
+		}
+		uplinkB, err := proto.Marshal(&uplink)
+		assert.NoError(err)
+
+		resp, err := http.Post(s.URL+"?event=up", "application/protobuf", bytes.NewBuffer(uplinkB))
+		assert.NoError(err)
+		assert.Equal(200, resp.StatusCode)
+
+		select {
+		case data := <-received:
+			assert.Equal(cmdB, data)
+		case <-time.After(time.Second):
+			t.Fatal("uplink was not forwarded")
+		}
+	})
 }
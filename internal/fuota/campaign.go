@@ -0,0 +1,365 @@
+package fuota
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/storage"
+)
+
+// Errors returned by the campaign functions.
+var (
+	ErrInvalidCampaignWaves     = errors.New("wave percentages must be greater than 0 and sum up to at most 100")
+	ErrInvalidSuccessThreshold  = errors.New("success threshold must be between 0 and 100")
+	ErrCampaignFragStatusNeeded = errors.New("campaign requires the fragmentation-session status to be requested")
+)
+
+// CampaignOptions defines the options for a staged rollout.
+type CampaignOptions struct {
+	// Deployment contains the options used for the deployment of each wave.
+	// The devices are split over the waves. The StartAt option is ignored as
+	// the waves are started by the campaign.
+	Deployment DeploymentOptions
+
+	// WavePercentages defines the percentage (0 - 100] of the devices to
+	// include in each wave. Devices that are not covered are deployed in a
+	// final wave.
+	WavePercentages []float64
+
+	// SuccessThreshold defines the percentage [0 - 100] of the devices of a
+	// wave that must report a successful fragmentation-session status before
+	// the next wave is started.
+	SuccessThreshold float64
+}
+
+// running campaigns within this process.
+var (
+	runningCampaignsMux sync.Mutex
+	runningCampaigns    = make(map[uuid.UUID]struct{})
+)
+
+// NewCampaign creates a new campaign, creating a pending deployment for each
+// wave. The returned campaign must be started using StartCampaign.
+func NewCampaign(ctx context.Context, opts CampaignOptions) (uuid.UUID, error) {
+	if opts.SuccessThreshold < 0 || opts.SuccessThreshold > 100 {
+		return uuid.Nil, ErrInvalidSuccessThreshold
+	}
+
+	var total float64
+	for _, p := range opts.WavePercentages {
+		if p <= 0 {
+			return uuid.Nil, ErrInvalidCampaignWaves
+		}
+		total += p
+	}
+	if total > 100 {
+		return uuid.Nil, ErrInvalidCampaignWaves
+	}
+
+	if opts.Deployment.RequestFragmentationSessionStatus == RequestFragmentationSessionStatusNoRequest {
+		return uuid.Nil, ErrCampaignFragStatusNeeded
+	}
+
+	var devEUIs []lorawan.EUI64
+	for devEUI := range opts.Deployment.Devices {
+		devEUIs = append(devEUIs, devEUI)
+	}
+	sort.Slice(devEUIs, func(i, j int) bool {
+		return bytes.Compare(devEUIs[i][:], devEUIs[j][:]) < 0
+	})
+
+	c := storage.Campaign{
+		ApplicationID:    opts.Deployment.ApplicationID,
+		SuccessThreshold: opts.SuccessThreshold,
+	}
+
+	if err := storage.Transaction(func(tx sqlx.Ext) error {
+		if err := storage.CreateCampaign(ctx, tx, &c); err != nil {
+			return fmt.Errorf("create campaign error: %w", err)
+		}
+
+		for i, wave := range splitWaves(devEUIs, opts.WavePercentages) {
+			id, err := uuid.NewV4()
+			if err != nil {
+				return fmt.Errorf("new uuid error: %w", err)
+			}
+
+			waveOpts := opts.Deployment
+			waveOpts.StartAt = nil
+			waveOpts.Devices = make(map[lorawan.EUI64]DeviceOptions)
+			for _, devEUI := range wave {
+				waveOpts.Devices[devEUI] = opts.Deployment.Devices[devEUI]
+			}
+
+			if err := createDeployment(ctx, tx, newDeployment(id, waveOpts), &c.ID, i); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return uuid.Nil, err
+	}
+
+	log.WithFields(log.Fields{
+		"campaign_id":  c.ID,
+		"device_count": len(devEUIs),
+	}).Info("fuota: campaign created")
+
+	return c.ID, nil
+}
+
+// splitWaves splits the given devices into waves using the given
+// percentages. The remaining devices are added as final wave. Empty waves
+// are omitted.
+func splitWaves(devEUIs []lorawan.EUI64, percentages []float64) [][]lorawan.EUI64 {
+	var waves [][]lorawan.EUI64
+	remaining := devEUIs
+
+	for _, p := range percentages {
+		n := int(math.Ceil(p * float64(len(devEUIs)) / 100))
+		if n > len(remaining) {
+			n = len(remaining)
+		}
+		if n == 0 {
+			continue
+		}
+
+		waves = append(waves, remaining[:n])
+		remaining = remaining[n:]
+	}
+
+	if len(remaining) != 0 {
+		waves = append(waves, remaining)
+	}
+
+	return waves
+}
+
+// ResumeCampaigns continues running the campaigns that did not end (e.g.
+// because of a server restart). This must be called after
+// ResumeDeployments.
+func ResumeCampaigns(ctx context.Context) error {
+	ids, err := storage.GetRunningCampaignIDs(ctx, storage.DB())
+	if err != nil {
+		return fmt.Errorf("get running campaign ids error: %w", err)
+	}
+
+	for _, id := range ids {
+		log.WithField("campaign_id", id).Info("fuota: resuming campaign")
+		StartCampaign(id)
+	}
+
+	return nil
+}
+
+// StartCampaign runs the campaign with the given ID in the background.
+// Calling StartCampaign for a campaign that is already running is a no-op.
+func StartCampaign(id uuid.UUID) {
+	runningCampaignsMux.Lock()
+	if _, ok := runningCampaigns[id]; ok {
+		runningCampaignsMux.Unlock()
+		return
+	}
+	runningCampaigns[id] = struct{}{}
+	runningCampaignsMux.Unlock()
+
+	go func() {
+		defer func() {
+			runningCampaignsMux.Lock()
+			delete(runningCampaigns, id)
+			runningCampaignsMux.Unlock()
+		}()
+
+		ctx := context.Background()
+		if err := runCampaign(ctx, id); err != nil {
+			log.WithError(err).WithField("campaign_id", id).Error("fuota: campaign error")
+
+			if err := endCampaign(ctx, id, storage.CampaignStateFailed, err.Error()); err != nil {
+				log.WithError(err).WithField("campaign_id", id).Error("fuota: end campaign error")
+			}
+		}
+	}()
+}
+
+// runCampaign deploys the waves of the given campaign one after the other.
+// Once a wave has ended, its success rate is compared against the success
+// threshold of the campaign. When below, the campaign is halted.
+func runCampaign(ctx context.Context, id uuid.UUID) error {
+	c, err := storage.GetCampaign(ctx, storage.DB(), id)
+	if err != nil {
+		return fmt.Errorf("get campaign error: %w", err)
+	}
+
+	waves, err := storage.GetCampaignWaves(ctx, storage.DB(), id)
+	if err != nil {
+		return fmt.Errorf("get campaign waves error: %w", err)
+	}
+
+	for i := range waves {
+		w := waves[i]
+
+		if w.State == storage.DeploymentStatePending {
+			if err := startWave(ctx, w); err != nil {
+				return err
+			}
+		}
+
+		if err := waitDeployment(ctx, w.DeploymentID); err != nil {
+			return err
+		}
+
+		// reload the wave for the final state and success count
+		waves, err = storage.GetCampaignWaves(ctx, storage.DB(), id)
+		if err != nil {
+			return fmt.Errorf("get campaign waves error: %w", err)
+		}
+		w = waves[i]
+
+		var reason string
+		switch w.State {
+		case storage.DeploymentStatePending, storage.DeploymentStateRunning:
+			return fmt.Errorf("deployment of wave %d did not end", w.Wave)
+		case storage.DeploymentStateFailed, storage.DeploymentStateCancelled:
+			reason = fmt.Sprintf("deployment of wave %d ended with state %s", w.Wave, w.State)
+		default:
+			if rate := waveSuccessRate(w); rate < c.SuccessThreshold {
+				reason = fmt.Sprintf("success rate of wave %d (%.1f%%) is below the threshold (%.1f%%)", w.Wave, rate, c.SuccessThreshold)
+			}
+		}
+
+		log.WithFields(log.Fields{
+			"campaign_id":   id,
+			"wave":          w.Wave,
+			"deployment_id": w.DeploymentID,
+			"state":         w.State,
+			"success_rate":  waveSuccessRate(w),
+		}).Info("fuota: campaign wave ended")
+
+		if reason != "" {
+			if err := cancelPendingWaves(ctx, waves[i+1:]); err != nil {
+				return err
+			}
+
+			log.WithFields(log.Fields{
+				"campaign_id": id,
+				"reason":      reason,
+			}).Warning("fuota: campaign halted")
+
+			return endCampaign(ctx, id, storage.CampaignStateHalted, reason)
+		}
+	}
+
+	log.WithField("campaign_id", id).Info("fuota: campaign completed")
+
+	return endCampaign(ctx, id, storage.CampaignStateCompleted, "")
+}
+
+// waveSuccessRate returns the percentage of devices of the given wave that
+// reported a successful fragmentation-session status.
+func waveSuccessRate(w storage.CampaignWave) float64 {
+	if w.DeviceCount == 0 {
+		return 0
+	}
+
+	return float64(w.FragStatusSuccess) / float64(w.DeviceCount) * 100
+}
+
+// startWave starts the deployment of the given wave.
+func startWave(ctx context.Context, w storage.CampaignWave) error {
+	sd, err := storage.GetDeployment(ctx, storage.DB(), w.DeploymentID)
+	if err != nil {
+		return fmt.Errorf("get deployment error: %w", err)
+	}
+	now := time.Now()
+	sd.StartAt = &now
+	if err := storage.UpdateDeployment(ctx, storage.DB(), &sd); err != nil {
+		return fmt.Errorf("update deployment error: %w", err)
+	}
+
+	d, err := LoadDeployment(ctx, w.DeploymentID)
+	if err != nil {
+		return fmt.Errorf("load deployment error: %w", err)
+	}
+
+	log.WithFields(log.Fields{
+		"campaign_id":   sd.CampaignID,
+		"wave":          w.Wave,
+		"deployment_id": w.DeploymentID,
+	}).Info("fuota: starting campaign wave")
+
+	Start(d)
+
+	return nil
+}
+
+// waitDeployment blocks until the given deployment is no longer running
+// within this process.
+func waitDeployment(ctx context.Context, id uuid.UUID) error {
+	runningMux.Lock()
+	rd, ok := running[id]
+	runningMux.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	select {
+	case <-rd.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// cancelPendingWaves marks the deployments of the given pending waves as
+// cancelled.
+func cancelPendingWaves(ctx context.Context, waves []storage.CampaignWave) error {
+	for _, w := range waves {
+		if w.State != storage.DeploymentStatePending {
+			continue
+		}
+
+		sd, err := storage.GetDeployment(ctx, storage.DB(), w.DeploymentID)
+		if err != nil {
+			return fmt.Errorf("get deployment error: %w", err)
+		}
+		now := time.Now()
+		sd.CancelledAt = &now
+		sd.State = storage.DeploymentStateCancelled
+		sd.ErrorMessage = "campaign halted"
+		if err := storage.UpdateDeployment(ctx, storage.DB(), &sd); err != nil {
+			return fmt.Errorf("update deployment error: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// endCampaign persists the final campaign state.
+func endCampaign(ctx context.Context, id uuid.UUID, state storage.CampaignState, errorMessage string) error {
+	c, err := storage.GetCampaign(ctx, storage.DB(), id)
+	if err != nil {
+		return fmt.Errorf("get campaign error: %w", err)
+	}
+	now := time.Now()
+	c.CompletedAt = &now
+	c.State = state
+	c.ErrorMessage = errorMessage
+	if err := storage.UpdateCampaign(ctx, storage.DB(), &c); err != nil {
+		return fmt.Errorf("update campaign error: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package fuota
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lorawan"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/storage"
+)
+
+func TestSplitWaves(t *testing.T) {
+	var devEUIs []lorawan.EUI64
+	for i := 0; i < 200; i++ {
+		devEUIs = append(devEUIs, lorawan.EUI64{0, 0, 0, 0, 0, 0, byte(i >> 8), byte(i)})
+	}
+
+	tests := []struct {
+		name        string
+		devEUIs     []lorawan.EUI64
+		percentages []float64
+		expected    []int
+	}{
+		{
+			name:     "no percentages",
+			devEUIs:  devEUIs,
+			expected: []int{200},
+		},
+		{
+			name:        "canary",
+			devEUIs:     devEUIs,
+			percentages: []float64{1, 10},
+			expected:    []int{2, 20, 178},
+		},
+		{
+			name:        "rounded up",
+			devEUIs:     devEUIs[:10],
+			percentages: []float64{1, 10},
+			expected:    []int{1, 1, 8},
+		},
+		{
+			name:        "all devices covered",
+			devEUIs:     devEUIs[:10],
+			percentages: []float64{50, 50},
+			expected:    []int{5, 5},
+		},
+		{
+			name:        "empty waves omitted",
+			devEUIs:     devEUIs[:2],
+			percentages: []float64{50, 50, 10},
+			expected:    []int{1, 1},
+		},
+		{
+			name:        "no devices",
+			percentages: []float64{10},
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.name, func(t *testing.T) {
+			assert := require.New(t)
+
+			waves := splitWaves(tst.devEUIs, tst.percentages)
+
+			var sizes []int
+			var all []lorawan.EUI64
+			for _, w := range waves {
+				sizes = append(sizes, len(w))
+				all = append(all, w...)
+			}
+			assert.Equal(tst.expected, sizes)
+			assert.Equal(tst.devEUIs, all)
+		})
+	}
+}
+
+func TestWaveSuccessRate(t *testing.T) {
+	assert := require.New(t)
+
+	assert.Equal(0.0, waveSuccessRate(storage.CampaignWave{}))
+	assert.Equal(75.0, waveSuccessRate(storage.CampaignWave{DeviceCount: 4, FragStatusSuccess: 3}))
+}
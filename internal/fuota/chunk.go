@@ -0,0 +1,213 @@
+package fuota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/delta"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/storage"
+)
+
+// maxChunkCount defines the max. number of chunks, as the chunk index is
+// signalled using a single byte of the descriptor.
+const maxChunkCount = 256
+
+// errTooManyChunks is returned when the payload would be split into more than
+// maxChunkCount chunks.
+var errTooManyChunks = errors.New("the payload exceeds the max. number of chunks, increase the chunk_size or frag_size")
+
+// chunkSize returns the max. number of payload bytes per
+// fragmentation-session. This is limited by the max. fragment number, as
+// the redundancy (and repair) fragments continue after the data fragments.
+// The size is rounded down to a multiple of the fragment size, so that only
+// the last chunk needs padding.
+func (d *Deployment) chunkSize() int {
+	if d.opts.FragSize <= 0 {
+		return len(d.payload)
+	}
+
+	size := (maxFragmentN - d.opts.Redundancy) * d.opts.FragSize
+	if d.opts.ChunkSize > 0 && d.opts.ChunkSize < size {
+		size = d.opts.ChunkSize
+	}
+	if size > d.opts.FragSize {
+		size -= size % d.opts.FragSize
+	}
+	if size < d.opts.FragSize {
+		size = d.opts.FragSize
+	}
+
+	return size
+}
+
+// chunkCount returns the number of chunks (fragmentation-sessions) in which
+// the payload is transferred.
+func (d *Deployment) chunkCount() int {
+	size := d.chunkSize()
+	if size <= 0 || len(d.payload) <= size {
+		return 1
+	}
+
+	return (len(d.payload) + size - 1) / size
+}
+
+// chunkPayload returns the part of the payload that is transferred by the
+// current fragmentation-session. The capacity of the returned slice is
+// limited to its length, so that appending (e.g. the padding) does not
+// overwrite the next chunk.
+func (d *Deployment) chunkPayload() []byte {
+	size := d.chunkSize()
+	if size <= 0 {
+		return d.payload
+	}
+
+	start := min(int(d.chunk.Load())*size, len(d.payload))
+	end := min(start+size, len(d.payload))
+
+	return d.payload[start:end:end]
+}
+
+// fragIndex returns the FragIndex of the current fragmentation-session. Each
+// chunk uses the next FragIndex (modulo 4), starting at the
+// FragmentationSessionIndex.
+func (d *Deployment) fragIndex() uint8 {
+	return uint8((int(d.opts.FragmentationSessionIndex) + int(d.chunk.Load())) % 4)
+}
+
+// validateChunks returns an error when the payload can not be split into
+// chunks. As the chunk index is signalled using the last byte of the
+// descriptor, this byte must not be used by the descriptor itself. The
+// descriptor of a delta payload contains the hash of the base image.
+func (d *Deployment) validateChunks() error {
+	count := d.chunkCount()
+	if count > maxChunkCount {
+		return errTooManyChunks
+	}
+
+	if count > 1 && (d.opts.Descriptor[3] != 0 || d.opts.Descriptor[0]&0x0f == delta.DescriptorFormatJanpatch) {
+		return ErrChunkDescriptor
+	}
+
+	return nil
+}
+
+// descriptor returns the descriptor of the current fragmentation-session.
+// When the payload is split into chunks, the last byte of the descriptor
+// contains the index of the chunk. The offset of the chunk within the payload
+// is the index multiplied by the size of the first chunk.
+func (d *Deployment) descriptor() [4]byte {
+	desc := d.opts.Descriptor
+	if d.chunkCount() > 1 {
+		desc[3] = byte(d.chunk.Load())
+	}
+
+	return desc
+}
+
+// Advance to the next chunk of the payload. The devices are requested to
+// delete the fragmentation-session of the current chunk. This does not wait
+// for the answers, as the device-queue is processed in order the delete
+// request is received before the setup request of the next chunk. The
+// devices that completed the transfer of the current chunk continue with the
+// next chunk. Devices that did not complete the transfer are excluded from
+// the remaining chunks.
+func (d *Deployment) stepNextChunk(ctx context.Context) error {
+	chunk := int(d.chunk.Load())
+
+	for devEUI := range d.opts.Devices {
+		state, ok := d.deviceState[devEUI]
+		if !ok {
+			continue
+		}
+
+		if state.pendingFragmentationSessionDelete() {
+			if err := d.enqueueFragSessionDeleteReq(ctx, devEUI); err != nil {
+				log.WithError(err).WithFields(log.Fields{
+					"deployment_id": d.GetID(),
+					"dev_eui":       devEUI,
+				}).Error("fuota: enqueue FragSessionDeleteReq error")
+			}
+		}
+
+		if state.getExcluded() || !state.getMulticastSetup() {
+			continue
+		}
+
+		dd, err := storage.GetDeploymentDevice(ctx, storage.DB(), d.GetID(), devEUI)
+		if err != nil {
+			return fmt.Errorf("get deployment device error: %w", err)
+		}
+
+		if d.transferCompleted(state) {
+			dd.ChunksCompleted = chunk + 1
+			dd.FragSessionSetupCompletedAt = nil
+			dd.MCSessionCompletedAt = nil
+			dd.FragStatusCompletedAt = nil
+			dd.FragDataBlockReceivedAt = nil
+
+			// the session counter must increase for every v2
+			// fragmentation-session of the device
+			if d.fragmentationPackageVersion() == fragmentationPackageVersion2 {
+				dd.FragSessionCnt++
+			}
+		} else {
+			if dd.FailureReason == storage.FailureReasonNone {
+				dd.FailureReason = d.deviceNoAnswerReason(devEUI)
+			}
+			state.setExcluded(true)
+
+			log.WithFields(log.Fields{
+				"deployment_id":  d.GetID(),
+				"dev_eui":        devEUI,
+				"chunk":          chunk,
+				"failure_reason": dd.FailureReason,
+			}).Warning("fuota: device did not complete chunk, excluding device from remaining chunks")
+		}
+
+		state.setFragmentationSessionSetup(false)
+		state.setFragmentationSessionReq(false)
+		state.setFragmentationSessionDelete(false)
+		state.setMulicastSessionSetup(false)
+		state.setFragmentationSessionStatus(false)
+		state.setMissingFrag(0)
+
+		if err := storage.UpdateDeploymentDevice(ctx, storage.DB(), &dd); err != nil {
+			return fmt.Errorf("update deployment device error: %w", err)
+		}
+	}
+
+	sd, err := storage.GetDeployment(ctx, storage.DB(), d.GetID())
+	if err != nil {
+		return fmt.Errorf("get deployment error: %w", err)
+	}
+	sd.ChunksCompleted = chunk + 1
+	sd.FragSessionSetupCompletedAt = nil
+	sd.MCSessionCompletedAt = nil
+	sd.EnqueueCompletedAt = nil
+	sd.FragStatusCompletedAt = nil
+	sd.FragRepairCompletedAt = nil
+	sd.RepairFragCount = 0
+	if err := storage.UpdateDeployment(ctx, storage.DB(), &sd); err != nil {
+		return fmt.Errorf("update deployment error: %w", err)
+	}
+
+	d.chunk.Store(int32(chunk + 1))
+
+	// discard the status signal of the previous chunk
+	select {
+	case <-d.fragmentationSessionStatusDone:
+	default:
+	}
+
+	log.WithFields(log.Fields{
+		"deployment_id": d.GetID(),
+		"chunk":         chunk + 1,
+		"chunk_count":   d.chunkCount(),
+		"frag_index":    d.fragIndex(),
+	}).Info("fuota: starting transfer of next chunk")
+
+	return nil
+}
@@ -0,0 +1,257 @@
+package fuota
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/applayer/fragmentation"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/client/as"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/delta"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/storage"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/test"
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+)
+
+func TestChunks(t *testing.T) {
+	payload := make([]byte, 95)
+	for i := range payload {
+		payload[i] = byte(i)
+	}
+
+	tests := []struct {
+		name       string
+		opts       DeploymentOptions
+		chunkSize  int
+		chunkCount int
+	}{
+		{"single chunk", DeploymentOptions{Payload: payload, FragSize: 10}, maxFragmentN * 10, 1},
+		{"chunk size", DeploymentOptions{Payload: payload, FragSize: 10, ChunkSize: 40}, 40, 3},
+		{"chunk size rounded to fragment size", DeploymentOptions{Payload: payload, FragSize: 10, ChunkSize: 45}, 40, 3},
+		{"chunk size smaller than fragment size", DeploymentOptions{Payload: payload, FragSize: 10, ChunkSize: 5}, 10, 10},
+		{"max. fragment number", DeploymentOptions{Payload: payload, FragSize: 1, Redundancy: maxFragmentN - 30}, 30, 4},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.name, func(t *testing.T) {
+			assert := require.New(t)
+
+			d := newDeployment(uuid.Nil, tst.opts)
+			assert.Equal(tst.chunkSize, d.chunkSize())
+			assert.Equal(tst.chunkCount, d.chunkCount())
+
+			// the chunks must cover the complete payload
+			var b []byte
+			for i := 0; i < d.chunkCount(); i++ {
+				d.chunk.Store(int32(i))
+				b = append(b, d.chunkPayload()...)
+			}
+			assert.Equal(payload, b)
+		})
+	}
+
+	t.Run("Padding does not overwrite the next chunk", func(t *testing.T) {
+		assert := require.New(t)
+
+		payload := bytes.Repeat([]byte{0x01}, 45)
+		d := newDeployment(uuid.Nil, DeploymentOptions{Payload: payload, FragSize: 10, ChunkSize: 20})
+
+		fragments, err := d.encodeFragments(0)
+		assert.NoError(err)
+		assert.Len(fragments, 2)
+
+		d.chunk.Store(2)
+		fragments, err = d.encodeFragments(0)
+		assert.NoError(err)
+		assert.Equal([][]byte{{0x01, 0x01, 0x01, 0x01, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00}}, fragments)
+		assert.Equal(bytes.Repeat([]byte{0x01}, 45), d.payload)
+	})
+
+	t.Run("FragIndex", func(t *testing.T) {
+		assert := require.New(t)
+
+		d := newDeployment(uuid.Nil, DeploymentOptions{FragmentationSessionIndex: 2})
+		for i, fragIndex := range []uint8{2, 3, 0, 1, 2} {
+			d.chunk.Store(int32(i))
+			assert.Equal(fragIndex, d.fragIndex())
+		}
+	})
+
+	t.Run("Descriptor", func(t *testing.T) {
+		assert := require.New(t)
+
+		descriptor := [4]byte{0x01, 0x02, 0x03, 0x04}
+
+		d := newDeployment(uuid.Nil, DeploymentOptions{Payload: payload, FragSize: 10, Descriptor: descriptor})
+		assert.Equal(descriptor, d.descriptor())
+
+		d = newDeployment(uuid.Nil, DeploymentOptions{Payload: payload, FragSize: 10, ChunkSize: 40, Descriptor: descriptor})
+		for i := 0; i < d.chunkCount(); i++ {
+			d.chunk.Store(int32(i))
+			assert.Equal([4]byte{0x01, 0x02, 0x03, byte(i)}, d.descriptor())
+		}
+	})
+
+	t.Run("Validate", func(t *testing.T) {
+		deltaDescriptor := delta.Descriptor([]byte("base image"))
+
+		tests := []struct {
+			name string
+			opts DeploymentOptions
+			err  error
+		}{
+			{"single chunk", DeploymentOptions{Payload: payload, FragSize: 10, Descriptor: [4]byte{0x01, 0x02, 0x03, 0x04}}, nil},
+			{"chunks", DeploymentOptions{Payload: payload, FragSize: 10, ChunkSize: 40, Descriptor: [4]byte{0x02, 0x02, 0x03}}, nil},
+			{"chunks with descriptor byte 3", DeploymentOptions{Payload: payload, FragSize: 10, ChunkSize: 40, Descriptor: [4]byte{0x02, 0x02, 0x03, 0x04}}, ErrChunkDescriptor},
+			{"single chunk delta", DeploymentOptions{Payload: payload, FragSize: 10, Descriptor: deltaDescriptor}, nil},
+			{"chunks delta", DeploymentOptions{Payload: payload, FragSize: 10, ChunkSize: 40, Descriptor: deltaDescriptor}, ErrChunkDescriptor},
+			{"chunks compressed delta", DeploymentOptions{Payload: payload, FragSize: 1, ChunkSize: 1, Compression: CompressionLZ4, Descriptor: [4]byte{delta.DescriptorFormatJanpatch}}, ErrChunkDescriptor},
+			{"too many chunks", DeploymentOptions{Payload: make([]byte, maxChunkCount+1), FragSize: 1, ChunkSize: 1}, errTooManyChunks},
+		}
+
+		for _, tst := range tests {
+			t.Run(tst.name, func(t *testing.T) {
+				d := newDeployment(uuid.Nil, tst.opts)
+				require.Equal(t, tst.err, d.validateChunks())
+			})
+		}
+	})
+}
+
+func (s *FUOTATestSuite) TestNextChunk() {
+	assert := require.New(s.T())
+
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	mock := test.NewMockDeviceServiceClient(ctrl)
+	as.SetDeviceClient(mock)
+
+	devCompleted := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+	devFailed := lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+
+	d, err := NewDeployment(DeploymentOptions{
+		ApplicationID: "app-1",
+		Devices: map[lorawan.EUI64]DeviceOptions{
+			devCompleted: {},
+			devFailed:    {},
+		},
+		UnicastTimeout:                    time.Second,
+		UnicastAttemptCount:               1,
+		FragSize:                          10,
+		ChunkSize:                         20,
+		Payload:                           bytes.Repeat([]byte{0x01}, 50),
+		FragmentationSessionIndex:         3,
+		FragmentationPackageVersion:       fragmentationPackageVersion2,
+		RequestFragmentationSessionStatus: RequestFragmentationSessionStatusAfterFragmentEnqueue,
+	})
+	assert.NoError(err)
+	assert.Equal(3, d.chunkCount())
+
+	sd, err := storage.GetDeployment(context.Background(), storage.DB(), d.GetID())
+	assert.NoError(err)
+	assert.Equal(20, sd.ChunkSize)
+	assert.Equal(3, sd.ChunkCount)
+
+	for devEUI, state := range d.deviceState {
+		state.setMulticastSetup(true)
+		state.setFragmentationSessionSetup(true)
+		state.setMulicastSessionSetup(true)
+		state.setFragmentationSessionStatus(devEUI == devCompleted)
+	}
+
+	ddCompleted, err := storage.GetDeploymentDevice(context.Background(), storage.DB(), d.GetID(), devCompleted)
+	assert.NoError(err)
+
+	// the fragmentation-session of the current chunk is deleted by all
+	// devices, without waiting for the answers
+	var deleted []lorawan.EUI64
+	mock.EXPECT().Enqueue(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, r *api.EnqueueDeviceQueueItemRequest, opts ...interface{}) (*api.EnqueueDeviceQueueItemResponse, error) {
+			var devEUI lorawan.EUI64
+			assert.NoError(devEUI.UnmarshalText([]byte(r.GetQueueItem().GetDevEui())))
+			deleted = append(deleted, devEUI)
+
+			var cmd fragmentation.Command
+			assert.NoError(cmd.UnmarshalBinary(false, r.GetQueueItem().GetData()))
+			assert.Equal(fragmentation.FragSessionDeleteReq, cmd.CID)
+			pl, ok := cmd.Payload.(*fragmentation.FragSessionDeleteReqPayload)
+			assert.True(ok)
+			assert.Equal(uint8(3), pl.Param.FragIndex)
+
+			return &api.EnqueueDeviceQueueItemResponse{}, nil
+		},
+	).Times(2)
+
+	assert.NoError(d.stepNextChunk(context.Background()))
+	assert.ElementsMatch([]lorawan.EUI64{devCompleted, devFailed}, deleted)
+	assert.Equal(uint8(0), d.fragIndex())
+	assert.Equal([4]byte{0x00, 0x00, 0x00, 0x01}, d.descriptor())
+
+	s.T().Run("Deployment", func(t *testing.T) {
+		assert := require.New(t)
+
+		sd, err := storage.GetDeployment(context.Background(), storage.DB(), d.GetID())
+		assert.NoError(err)
+		assert.Equal(1, sd.ChunksCompleted)
+		assert.Nil(sd.FragSessionSetupCompletedAt)
+		assert.Nil(sd.FragStatusCompletedAt)
+	})
+
+	s.T().Run("Completed device", func(t *testing.T) {
+		assert := require.New(t)
+
+		state := d.deviceState[devCompleted]
+		assert.False(state.getExcluded())
+		assert.True(state.getMulticastSetup())
+		assert.False(state.getFragmentationSessionSetup())
+		assert.False(state.getFragmentationSessionDelete())
+		assert.False(state.getFragmentationSessionStatus())
+
+		dd, err := storage.GetDeploymentDevice(context.Background(), storage.DB(), d.GetID(), devCompleted)
+		assert.NoError(err)
+		assert.Equal(1, dd.ChunksCompleted)
+		assert.Equal(ddCompleted.FragSessionCnt+1, dd.FragSessionCnt)
+		assert.Equal(storage.FailureReasonNone, dd.FailureReason)
+	})
+
+	s.T().Run("Failed device", func(t *testing.T) {
+		assert := require.New(t)
+
+		state := d.deviceState[devFailed]
+		assert.True(state.getExcluded())
+
+		dd, err := storage.GetDeploymentDevice(context.Background(), storage.DB(), d.GetID(), devFailed)
+		assert.NoError(err)
+		assert.Equal(0, dd.ChunksCompleted)
+		assert.Equal(storage.FailureReasonFragSessionStatusNoAnswer, dd.FailureReason)
+	})
+
+	s.T().Run("LoadDeployment", func(t *testing.T) {
+		assert := require.New(t)
+
+		dd, err := storage.GetDeploymentDevice(context.Background(), storage.DB(), d.GetID(), devCompleted)
+		assert.NoError(err)
+		now := time.Now()
+		dd.MCGroupSetupCompletedAt = &now
+		assert.NoError(storage.UpdateDeploymentDevice(context.Background(), storage.DB(), &dd))
+
+		dd, err = storage.GetDeploymentDevice(context.Background(), storage.DB(), d.GetID(), devFailed)
+		assert.NoError(err)
+		dd.MCGroupSetupCompletedAt = &now
+		assert.NoError(storage.UpdateDeploymentDevice(context.Background(), storage.DB(), &dd))
+
+		d2, err := LoadDeployment(context.Background(), d.GetID())
+		assert.NoError(err)
+		assert.Equal(int32(1), d2.chunk.Load())
+		assert.Equal(uint8(0), d2.fragIndex())
+		assert.False(d2.deviceState[devCompleted].getExcluded())
+		assert.True(d2.deviceState[devFailed].getExcluded())
+	})
+}
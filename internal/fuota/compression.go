@@ -0,0 +1,36 @@
+package fuota
+
+import (
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/lz4"
+)
+
+// compressionDescriptor returns the value of the 4 most significant bits of
+// the first descriptor byte for the given compression.
+func compressionDescriptor(c CompressionType) byte {
+	switch c {
+	case CompressionLZ4:
+		return 0x10
+	default:
+		return 0x00
+	}
+}
+
+// compressPayload returns the payload compressed using the given
+// compression. In case of LZ4, the payload is compressed as a size-prefixed
+// LZ4 block.
+func compressPayload(c CompressionType, payload []byte) []byte {
+	switch c {
+	case CompressionLZ4:
+		return lz4.Compress(payload)
+	default:
+		return payload
+	}
+}
+
+// compression returns the compression of the deployment.
+func (d *Deployment) compression() CompressionType {
+	if d.opts.Compression == "" {
+		return CompressionNone
+	}
+	return d.opts.Compression
+}
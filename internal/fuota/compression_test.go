@@ -0,0 +1,50 @@
+package fuota
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/lz4"
+)
+
+func TestCompression(t *testing.T) {
+	payload := bytes.Repeat([]byte("firmware image "), 100)
+
+	t.Run("No compression", func(t *testing.T) {
+		assert := require.New(t)
+
+		d := newDeployment(uuid.Nil, DeploymentOptions{
+			Payload:    payload,
+			FragSize:   50,
+			Descriptor: [4]byte{0x01, 0x02, 0x03, 0x04},
+		})
+		assert.Equal(CompressionNone, d.compression())
+		assert.Equal(payload, d.payload)
+		assert.Equal([4]byte{0x01, 0x02, 0x03, 0x04}, d.opts.Descriptor)
+	})
+
+	t.Run("LZ4", func(t *testing.T) {
+		assert := require.New(t)
+
+		d := newDeployment(uuid.Nil, DeploymentOptions{
+			Payload:     payload,
+			FragSize:    50,
+			Compression: CompressionLZ4,
+			Descriptor:  [4]byte{0x01, 0x02, 0x03, 0x04},
+		})
+		assert.Equal(CompressionLZ4, d.compression())
+		assert.Equal([4]byte{0x11, 0x02, 0x03, 0x04}, d.opts.Descriptor)
+		assert.Less(len(d.payload), len(payload))
+
+		b, err := lz4.Decompress(d.payload)
+		assert.NoError(err)
+		assert.Equal(payload, b)
+
+		fragments, err := d.encodeFragments(0)
+		assert.NoError(err)
+		assert.Len(fragments, (len(d.payload)+49)/50)
+	})
+}
@@ -0,0 +1,103 @@
+package fuota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/client/as"
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+)
+
+// ErrEmptyDeviceSelector is returned when the device selector does not
+// contain any criteria.
+var ErrEmptyDeviceSelector = errors.New("device selector must contain a device-profile id and / or tags")
+
+// deviceListPageSize defines the number of devices requested per List call.
+const deviceListPageSize = 250
+
+// DeviceSelector selects the devices of an application by device-profile
+// and / or tags.
+type DeviceSelector struct {
+	// DeviceProfileID defines the device-profile ID (optional).
+	DeviceProfileID string
+
+	// Tags defines the tags that a device must have (optional).
+	Tags map[string]string
+}
+
+// matches returns true when the given device matches the selector.
+func (s DeviceSelector) matches(item *api.DeviceListItem) bool {
+	if s.DeviceProfileID != "" && item.GetDeviceProfileId() != s.DeviceProfileID {
+		return false
+	}
+
+	for k, v := range s.Tags {
+		if tv, ok := item.GetTags()[k]; !ok || tv != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SelectDevices returns the devices of the given application matching the
+// selector. The McRootKey of each device is derived from the device keys
+// stored in ChirpStack for the given Remote Multicast Setup package version.
+func SelectDevices(ctx context.Context, applicationID string, sel DeviceSelector, packageVersion uint8) (map[lorawan.EUI64]DeviceOptions, error) {
+	if sel.DeviceProfileID == "" && len(sel.Tags) == 0 {
+		return nil, ErrEmptyDeviceSelector
+	}
+
+	devices := make(map[lorawan.EUI64]DeviceOptions)
+	deriver := newMcRootKeyDeriver(packageVersion)
+
+	for offset := uint32(0); ; offset += deviceListPageSize {
+		resp, err := as.DeviceClient().List(ctx, &api.ListDevicesRequest{
+			ApplicationId:   applicationID,
+			DeviceProfileId: sel.DeviceProfileID,
+			Tags:            sel.Tags,
+			Limit:           deviceListPageSize,
+			Offset:          offset,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list devices error: %w", err)
+		}
+
+		for _, item := range resp.GetResult() {
+			// ChirpStack versions without device-profile and tag filters
+			// return all devices of the application.
+			if !sel.matches(item) {
+				continue
+			}
+
+			var devEUI lorawan.EUI64
+			if err := devEUI.UnmarshalText([]byte(item.GetDevEui())); err != nil {
+				return nil, fmt.Errorf("unmarshal dev_eui error: %w", err)
+			}
+
+			mcRootKey, err := deriver.mcRootKey(ctx, devEUI, item.GetDeviceProfileId())
+			if err != nil {
+				return nil, err
+			}
+
+			devices[devEUI] = DeviceOptions{
+				McRootKey: mcRootKey,
+			}
+		}
+
+		if len(resp.GetResult()) < deviceListPageSize {
+			break
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"application_id": applicationID,
+		"device_count":   len(devices),
+	}).Info("fuota: devices selected")
+
+	return devices, nil
+}
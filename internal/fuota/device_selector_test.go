@@ -0,0 +1,130 @@
+package fuota
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/applayer/multicastsetup"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/client/as"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/test"
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+	"github.com/chirpstack/chirpstack/api/go/v4/common"
+)
+
+func TestSelectDevices(t *testing.T) {
+	genAppKey := lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8}
+	mcRootKey, err := multicastsetup.GetMcRootKeyForGenAppKey(genAppKey)
+	require.NoError(t, err)
+
+	t.Run("Empty selector", func(t *testing.T) {
+		assert := require.New(t)
+
+		_, err := SelectDevices(context.Background(), "app-1", DeviceSelector{}, 1)
+		assert.Equal(ErrEmptyDeviceSelector, err)
+	})
+
+	t.Run("Paginated", func(t *testing.T) {
+		assert := require.New(t)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mock := test.NewMockDeviceServiceClient(ctrl)
+		as.SetDeviceClient(mock)
+		dpMock := test.NewMockDeviceProfileServiceClient(ctrl)
+		as.SetDeviceProfileClient(dpMock)
+
+		sel := DeviceSelector{
+			DeviceProfileID: "dp-1",
+			Tags:            map[string]string{"hw_rev": "b"},
+		}
+
+		var page1 []*api.DeviceListItem
+		for i := 0; i < deviceListPageSize; i++ {
+			page1 = append(page1, &api.DeviceListItem{
+				DevEui:          fmt.Sprintf("01010101%08x", i),
+				DeviceProfileId: "dp-1",
+				Tags:            map[string]string{"hw_rev": "b", "site": "x"},
+			})
+		}
+
+		// the last page contains devices that do not match the selector
+		page2 := []*api.DeviceListItem{
+			{DevEui: "0202020202020201", DeviceProfileId: "dp-1", Tags: map[string]string{"hw_rev": "b"}},
+			{DevEui: "0202020202020202", DeviceProfileId: "dp-2", Tags: map[string]string{"hw_rev": "b"}},
+			{DevEui: "0202020202020203", DeviceProfileId: "dp-1", Tags: map[string]string{"hw_rev": "a"}},
+			{DevEui: "0202020202020204", DeviceProfileId: "dp-1"},
+		}
+
+		gomock.InOrder(
+			mock.EXPECT().List(gomock.Any(), gomock.Eq(&api.ListDevicesRequest{
+				ApplicationId:   "app-1",
+				DeviceProfileId: "dp-1",
+				Tags:            sel.Tags,
+				Limit:           deviceListPageSize,
+				Offset:          0,
+			})).Return(&api.ListDevicesResponse{TotalCount: deviceListPageSize + 4, Result: page1}, nil),
+			mock.EXPECT().List(gomock.Any(), gomock.Eq(&api.ListDevicesRequest{
+				ApplicationId:   "app-1",
+				DeviceProfileId: "dp-1",
+				Tags:            sel.Tags,
+				Limit:           deviceListPageSize,
+				Offset:          deviceListPageSize,
+			})).Return(&api.ListDevicesResponse{TotalCount: deviceListPageSize + 4, Result: page2}, nil),
+		)
+
+		// the device-profile is only fetched once
+		dpMock.EXPECT().Get(gomock.Any(), gomock.Eq(&api.GetDeviceProfileRequest{
+			Id: "dp-1",
+		})).Return(&api.GetDeviceProfileResponse{
+			DeviceProfile: &api.DeviceProfile{MacVersion: common.MacVersion_LORAWAN_1_0_3},
+		}, nil)
+
+		mock.EXPECT().GetKeys(gomock.Any(), gomock.Any()).Return(&api.GetDeviceKeysResponse{
+			DeviceKeys: &api.DeviceKeys{
+				GenAppKey: genAppKey.String(),
+			},
+		}, nil).Times(deviceListPageSize + 1)
+
+		devices, err := SelectDevices(context.Background(), "app-1", sel, 1)
+		assert.NoError(err)
+		assert.Len(devices, deviceListPageSize+1)
+		assert.Equal(DeviceOptions{McRootKey: mcRootKey}, devices[lorawan.EUI64{2, 2, 2, 2, 2, 2, 2, 1}])
+		assert.NotContains(devices, lorawan.EUI64{2, 2, 2, 2, 2, 2, 2, 2})
+	})
+
+	t.Run("GenAppKey not set", func(t *testing.T) {
+		assert := require.New(t)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mock := test.NewMockDeviceServiceClient(ctrl)
+		as.SetDeviceClient(mock)
+		dpMock := test.NewMockDeviceProfileServiceClient(ctrl)
+		as.SetDeviceProfileClient(dpMock)
+
+		dpMock.EXPECT().Get(gomock.Any(), gomock.Any()).Return(&api.GetDeviceProfileResponse{
+			DeviceProfile: &api.DeviceProfile{MacVersion: common.MacVersion_LORAWAN_1_0_3},
+		}, nil)
+		mock.EXPECT().List(gomock.Any(), gomock.Any()).Return(&api.ListDevicesResponse{
+			TotalCount: 1,
+			Result: []*api.DeviceListItem{
+				{DevEui: "0102030405060708", DeviceProfileId: "dp-1"},
+			},
+		}, nil)
+		mock.EXPECT().GetKeys(gomock.Any(), gomock.Eq(&api.GetDeviceKeysRequest{
+			DevEui: "0102030405060708",
+		})).Return(&api.GetDeviceKeysResponse{
+			DeviceKeys: &api.DeviceKeys{},
+		}, nil)
+
+		_, err := SelectDevices(context.Background(), "app-1", DeviceSelector{DeviceProfileID: "dp-1"}, 1)
+		assert.Error(err)
+	})
+}
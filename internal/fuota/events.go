@@ -0,0 +1,93 @@
+package fuota
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/storage"
+)
+
+// EventType defines the deployment event type.
+type EventType string
+
+// Deployment event types.
+const (
+	EventTypeStepStarted     EventType = "STEP_STARTED"
+	EventTypeStepFinished    EventType = "STEP_FINISHED"
+	EventTypeDeviceAnswer    EventType = "DEVICE_ANSWER"
+	EventTypeDeploymentLog   EventType = "DEPLOYMENT_LOG"
+	EventTypeDeploymentEnded EventType = "DEPLOYMENT_ENDED"
+)
+
+// eventBufferSize defines the number of events that are buffered per
+// subscriber. When a subscriber does not keep up, events are dropped.
+const eventBufferSize = 100
+
+// Event defines a deployment event.
+type Event struct {
+	Type         EventType
+	DeploymentID uuid.UUID
+	Time         time.Time
+
+	// Step is set for the STEP_STARTED and STEP_FINISHED events.
+	Step string
+
+	// Log is set for the DEVICE_ANSWER and DEPLOYMENT_LOG events.
+	Log *storage.DeploymentLog
+
+	// State and ErrorMessage are set for the DEPLOYMENT_ENDED event.
+	State        storage.DeploymentState
+	ErrorMessage string
+}
+
+var (
+	subscribersMux sync.RWMutex
+	subscribers    = make(map[uuid.UUID]map[chan Event]struct{})
+)
+
+// Subscribe subscribes to the events of the given deployment. The returned
+// function must be called to unsubscribe.
+func Subscribe(id uuid.UUID) (<-chan Event, func()) {
+	ch := make(chan Event, eventBufferSize)
+
+	subscribersMux.Lock()
+	if _, ok := subscribers[id]; !ok {
+		subscribers[id] = make(map[chan Event]struct{})
+	}
+	subscribers[id][ch] = struct{}{}
+	subscribersMux.Unlock()
+
+	return ch, func() {
+		subscribersMux.Lock()
+		defer subscribersMux.Unlock()
+
+		delete(subscribers[id], ch)
+		if len(subscribers[id]) == 0 {
+			delete(subscribers, id)
+		}
+	}
+}
+
+// publish publishes the given event to the subscribers of the deployment.
+func publish(e Event) {
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+
+	subscribersMux.RLock()
+	defer subscribersMux.RUnlock()
+
+	for ch := range subscribers[e.DeploymentID] {
+		select {
+		case ch <- e:
+		default:
+			log.WithFields(log.Fields{
+				"deployment_id": e.DeploymentID,
+				"event_type":    e.Type,
+			}).Warning("fuota: subscriber buffer full, dropping event")
+		}
+	}
+}
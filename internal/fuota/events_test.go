@@ -0,0 +1,58 @@
+package fuota
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvents(t *testing.T) {
+	assert := require.New(t)
+
+	id1, err := uuid.NewV4()
+	assert.NoError(err)
+	id2, err := uuid.NewV4()
+	assert.NoError(err)
+
+	events, unsubscribe := Subscribe(id1)
+
+	t.Run("Publish", func(t *testing.T) {
+		assert := require.New(t)
+
+		publish(Event{Type: EventTypeStepStarted, DeploymentID: id2, Step: "enqueue"})
+		publish(Event{Type: EventTypeStepStarted, DeploymentID: id1, Step: "enqueue"})
+
+		e := <-events
+		assert.Equal(EventTypeStepStarted, e.Type)
+		assert.Equal(id1, e.DeploymentID)
+		assert.Equal("enqueue", e.Step)
+		assert.False(e.Time.IsZero())
+		assert.Len(events, 0)
+	})
+
+	t.Run("Buffer full", func(t *testing.T) {
+		assert := require.New(t)
+
+		for i := 0; i < eventBufferSize+1; i++ {
+			publish(Event{Type: EventTypeDeploymentLog, DeploymentID: id1})
+		}
+		assert.Len(events, eventBufferSize)
+
+		for len(events) > 0 {
+			<-events
+		}
+	})
+
+	t.Run("Unsubscribe", func(t *testing.T) {
+		assert := require.New(t)
+
+		unsubscribe()
+		publish(Event{Type: EventTypeDeploymentEnded, DeploymentID: id1})
+		assert.Len(events, 0)
+
+		subscribersMux.RLock()
+		defer subscribersMux.RUnlock()
+		assert.NotContains(subscribers, id1)
+	})
+}
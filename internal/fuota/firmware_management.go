@@ -0,0 +1,573 @@
+package fuota
+
+import (
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq/hstore"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/applayer/firmwaremanagement"
+	"github.com/brocaar/lorawan/gps"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/client/as"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/storage"
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+)
+
+// handleFirmwareManagementCommand handles an uplink firmware management
+// command.
+func (d *Deployment) handleFirmwareManagementCommand(ctx context.Context, devEUI lorawan.EUI64, b []byte) error {
+	var cmd firmwaremanagement.Command
+	if err := cmd.UnmarshalBinary(true, b); err != nil {
+		return fmt.Errorf("unmarshal command error: %w", err)
+	}
+
+	switch cmd.CID {
+	case firmwaremanagement.PackageVersionAns:
+		pl, ok := cmd.Payload.(*firmwaremanagement.PackageVersionAnsPayload)
+		if !ok {
+			return fmt.Errorf("expected *firmwaremanagement.PackageVersionAnsPayload, got: %T", cmd.Payload)
+		}
+		return d.handlePackageVersionAns(ctx, devEUI, firmwaremanagement.DefaultFPort, pl.PackageIdentifier, pl.PackageVersion)
+	case firmwaremanagement.DevVersionAns:
+		pl, ok := cmd.Payload.(*firmwaremanagement.DevVersionAnsPayload)
+		if !ok {
+			return fmt.Errorf("expected *firmwaremanagement.DevVersionAnsPayload, got: %T", cmd.Payload)
+		}
+		return d.handleDevVersionAns(ctx, devEUI, pl)
+	case firmwaremanagement.DevUpgradeImageAns:
+		pl, ok := cmd.Payload.(*firmwaremanagement.DevUpgradeImageAnsPayload)
+		if !ok {
+			return fmt.Errorf("expected *firmwaremanagement.DevUpgradeImageAnsPayload, got: %T", cmd.Payload)
+		}
+
+		// the next firmware version is not exported by the payload, it is
+		// decoded from the command (the length has been validated by the
+		// unmarshal of the payload)
+		var nextFWVersion uint32
+		if pl.Status.IsFirmwareImageValid() {
+			nextFWVersion = binary.LittleEndian.Uint32(b[2:6])
+		}
+		return d.handleDevUpgradeImageAns(ctx, devEUI, pl.Status.UpImageStatus, nextFWVersion)
+	case firmwaremanagement.DevRebootTimeAns:
+		pl, ok := cmd.Payload.(*firmwaremanagement.DevRebootTimeAnsPayload)
+		if !ok {
+			return fmt.Errorf("expected *firmwaremanagement.DevRebootTimeAnsPayload, got: %T", cmd.Payload)
+		}
+		return d.handleDevRebootAns(ctx, devEUI, "DevRebootTimeAns", "reboot_time", pl.RebootTime)
+	case firmwaremanagement.DevRebootCountdownAns:
+		pl, ok := cmd.Payload.(*firmwaremanagement.DevRebootCountdownAnsPayload)
+		if !ok {
+			return fmt.Errorf("expected *firmwaremanagement.DevRebootCountdownAnsPayload, got: %T", cmd.Payload)
+		}
+		return d.handleDevRebootAns(ctx, devEUI, "DevRebootCountdownAns", "countdown", pl.Countdown)
+	}
+
+	return nil
+}
+
+// handleDevVersionAns handles the DevVersionAns. Before the reboot has been
+// scheduled, the reported versions are stored as the versions before the
+// deployment. After the reboot has been scheduled, the reported firmware
+// version must match the new firmware version.
+func (d *Deployment) handleDevVersionAns(ctx context.Context, devEUI lorawan.EUI64, pl *firmwaremanagement.DevVersionAnsPayload) error {
+	log.WithFields(log.Fields{
+		"deployment_id": d.GetID(),
+		"dev_eui":       devEUI,
+		"fw_version":    pl.FWversion,
+		"hw_version":    pl.HWversion,
+	}).Info("fuota: DevVersionAns received")
+
+	dl := storage.DeploymentLog{
+		DeploymentID: d.GetID(),
+		DevEUI:       devEUI,
+		FPort:        firmwaremanagement.DefaultFPort,
+		Command:      "DevVersionAns",
+		Fields: hstore.Hstore{
+			Map: map[string]sql.NullString{
+				"fw_version": sql.NullString{Valid: true, String: fmt.Sprintf("%d", pl.FWversion)},
+				"hw_version": sql.NullString{Valid: true, String: fmt.Sprintf("%d", pl.HWversion)},
+			},
+		},
+	}
+	d.createDeploymentLog(ctx, EventTypeDeviceAnswer, &dl)
+
+	state, ok := d.deviceState[devEUI]
+	if !d.opts.FirmwareManagement || !ok {
+		return nil
+	}
+
+	dd, err := storage.GetDeploymentDevice(ctx, storage.DB(), d.GetID(), devEUI)
+	if err != nil {
+		return fmt.Errorf("get deployment device error: %w", err)
+	}
+
+	if !state.getReboot() {
+		if state.getDevVersion() {
+			return nil
+		}
+
+		dd.FWVersionBefore = &pl.FWversion
+		dd.HWVersion = &pl.HWversion
+		if err := storage.UpdateDeploymentDevice(ctx, storage.DB(), &dd); err != nil {
+			return fmt.Errorf("update deployment device error: %w", err)
+		}
+
+		state.setDevVersion(true)
+		d.checkFirmwareManagementDone(d.devVersionDone, func(s *deviceState) bool {
+			return !s.getExcluded() && !s.getDevVersion()
+		})
+
+		return nil
+	}
+
+	if state.getFWVersion() {
+		return nil
+	}
+
+	dd.FWVersionAfter = &pl.FWversion
+	if pl.FWversion != d.expectedFWVersion(dd) {
+		log.WithFields(log.Fields{
+			"deployment_id":       d.GetID(),
+			"dev_eui":             devEUI,
+			"fw_version":          pl.FWversion,
+			"expected_fw_version": d.expectedFWVersion(dd),
+		}).Warning("fuota: device reported unexpected firmware version")
+
+		dd.FailureReason = storage.FailureReasonFWVersionMismatch
+		if err := storage.UpdateDeploymentDevice(ctx, storage.DB(), &dd); err != nil {
+			return fmt.Errorf("update deployment device error: %w", err)
+		}
+
+		return nil
+	}
+
+	now := time.Now()
+	dd.FWVersionCompletedAt = &now
+	dd.FailureReason = storage.FailureReasonNone
+	if err := storage.UpdateDeploymentDevice(ctx, storage.DB(), &dd); err != nil {
+		return fmt.Errorf("update deployment device error: %w", err)
+	}
+
+	state.setFWVersion(true)
+	d.checkFirmwareManagementDone(d.fwVersionDone, func(s *deviceState) bool {
+		return s.getReboot() && !s.getFWVersion()
+	})
+
+	return nil
+}
+
+// handleDevUpgradeImageAns handles the DevUpgradeImageAns. The image is
+// accepted when it is valid and, in case the FirmwareVersion option is set,
+// when the next firmware version matches.
+func (d *Deployment) handleDevUpgradeImageAns(ctx context.Context, devEUI lorawan.EUI64, status firmwaremanagement.UpImageStatus, nextFWVersion uint32) error {
+	log.WithFields(log.Fields{
+		"deployment_id":   d.GetID(),
+		"dev_eui":         devEUI,
+		"up_image_status": status,
+		"next_fw_version": nextFWVersion,
+	}).Info("fuota: DevUpgradeImageAns received")
+
+	dl := storage.DeploymentLog{
+		DeploymentID: d.GetID(),
+		DevEUI:       devEUI,
+		FPort:        firmwaremanagement.DefaultFPort,
+		Command:      "DevUpgradeImageAns",
+		Fields: hstore.Hstore{
+			Map: map[string]sql.NullString{
+				"up_image_status": sql.NullString{Valid: true, String: fmt.Sprintf("%d", status)},
+			},
+		},
+	}
+	if status == firmwaremanagement.FirmwareValid {
+		dl.Fields.Map["next_fw_version"] = sql.NullString{Valid: true, String: fmt.Sprintf("%d", nextFWVersion)}
+	}
+	d.createDeploymentLog(ctx, EventTypeDeviceAnswer, &dl)
+
+	state, ok := d.deviceState[devEUI]
+	if !d.opts.FirmwareManagement || !ok || state.getUpgradeImage() {
+		return nil
+	}
+
+	switch status {
+	case firmwaremanagement.NoFirmwarePresent:
+		return d.setDeviceFailureReason(ctx, devEUI, storage.FailureReasonUpgradeImageNoFirmware)
+	case firmwaremanagement.FirmwareCorruptOrInvalidSignature:
+		return d.setDeviceFailureReason(ctx, devEUI, storage.FailureReasonUpgradeImageInvalid)
+	case firmwaremanagement.FirmwareIncorrectHardware:
+		return d.setDeviceFailureReason(ctx, devEUI, storage.FailureReasonUpgradeImageIncorrectHardware)
+	}
+
+	dd, err := storage.GetDeploymentDevice(ctx, storage.DB(), d.GetID(), devEUI)
+	if err != nil {
+		return fmt.Errorf("get deployment device error: %w", err)
+	}
+	dd.FWVersionNext = &nextFWVersion
+
+	if d.opts.FirmwareVersion != 0 && nextFWVersion != d.opts.FirmwareVersion {
+		dd.FailureReason = storage.FailureReasonUpgradeImageVersionMismatch
+		if err := storage.UpdateDeploymentDevice(ctx, storage.DB(), &dd); err != nil {
+			return fmt.Errorf("update deployment device error: %w", err)
+		}
+
+		return nil
+	}
+
+	now := time.Now()
+	dd.UpgradeImageCompletedAt = &now
+	dd.FailureReason = storage.FailureReasonNone
+	if err := storage.UpdateDeploymentDevice(ctx, storage.DB(), &dd); err != nil {
+		return fmt.Errorf("update deployment device error: %w", err)
+	}
+
+	state.setUpgradeImage(true)
+	d.checkFirmwareManagementDone(d.upgradeImageDone, func(s *deviceState) bool {
+		return d.transferCompleted(s) && !s.getUpgradeImage()
+	})
+
+	return nil
+}
+
+// handleDevRebootAns handles the DevRebootTimeAns and DevRebootCountdownAns.
+func (d *Deployment) handleDevRebootAns(ctx context.Context, devEUI lorawan.EUI64, command, field string, value uint32) error {
+	log.WithFields(log.Fields{
+		"deployment_id": d.GetID(),
+		"dev_eui":       devEUI,
+		field:           value,
+	}).Infof("fuota: %s received", command)
+
+	dl := storage.DeploymentLog{
+		DeploymentID: d.GetID(),
+		DevEUI:       devEUI,
+		FPort:        firmwaremanagement.DefaultFPort,
+		Command:      command,
+		Fields: hstore.Hstore{
+			Map: map[string]sql.NullString{
+				field: sql.NullString{Valid: true, String: fmt.Sprintf("%d", value)},
+			},
+		},
+	}
+	d.createDeploymentLog(ctx, EventTypeDeviceAnswer, &dl)
+
+	state, ok := d.deviceState[devEUI]
+	if !d.opts.FirmwareManagement || !ok || !state.getUpgradeImage() || state.getReboot() {
+		return nil
+	}
+
+	dd, err := storage.GetDeploymentDevice(ctx, storage.DB(), d.GetID(), devEUI)
+	if err != nil {
+		return fmt.Errorf("get deployment device error: %w", err)
+	}
+	now := time.Now()
+	dd.RebootCompletedAt = &now
+	if err := storage.UpdateDeploymentDevice(ctx, storage.DB(), &dd); err != nil {
+		return fmt.Errorf("update deployment device error: %w", err)
+	}
+
+	state.setReboot(true)
+	d.checkFirmwareManagementDone(d.rebootDone, func(s *deviceState) bool {
+		return s.getUpgradeImage() && !s.getReboot()
+	})
+
+	return nil
+}
+
+// checkFirmwareManagementDone publishes to the given done chan when none of
+// the devices is pending.
+func (d *Deployment) checkFirmwareManagementDone(done chan struct{}, pending func(*deviceState) bool) {
+	for _, state := range d.deviceState {
+		if pending(state) {
+			return
+		}
+	}
+
+	// the channel is buffered, do not block when an answer is received after
+	// the step has completed
+	select {
+	case done <- struct{}{}:
+	default:
+	}
+}
+
+// transferCompleted returns true when the device completed the transfer of
+// the firmware image.
+func (d *Deployment) transferCompleted(state *deviceState) bool {
+	if !state.getMulticastSessionSetup() {
+		return false
+	}
+
+	return d.opts.RequestFragmentationSessionStatus == RequestFragmentationSessionStatusNoRequest || state.getFragmentationSessionStatus()
+}
+
+// expectedFWVersion returns the firmware version that the device must report
+// after the reboot.
+func (d *Deployment) expectedFWVersion(dd storage.DeploymentDevice) uint32 {
+	if d.opts.FirmwareVersion != 0 {
+		return d.opts.FirmwareVersion
+	}
+	if dd.FWVersionNext != nil {
+		return *dd.FWVersionNext
+	}
+	return 0
+}
+
+// rebootTime returns the time after which all devices have rebooted into the
+// new firmware.
+func (d *Deployment) rebootTime(sd storage.Deployment) time.Time {
+	if d.opts.RebootAt != nil {
+		return *d.opts.RebootAt
+	}
+	if sd.RebootCompletedAt != nil {
+		return sd.RebootCompletedAt.Add(d.opts.RebootCountdown)
+	}
+	return time.Now()
+}
+
+// requestFirmwareManagement enqueues the request to the devices for which
+// pending returns true, until all devices have answered or the max. number
+// of attempts has been reached.
+func (d *Deployment) requestFirmwareManagement(ctx context.Context, name string, done chan struct{}, pending func(*deviceState) bool, enqueue func(context.Context, lorawan.EUI64) error) error {
+	attempt := 0
+
+devLoop:
+	for {
+		var devEUIs []lorawan.EUI64
+		for devEUI := range d.opts.Devices {
+			if pending(d.deviceState[devEUI]) {
+				devEUIs = append(devEUIs, devEUI)
+			}
+		}
+
+		if len(devEUIs) == 0 {
+			break
+		}
+
+		attempt += 1
+		if attempt > d.opts.UnicastAttemptCount {
+			log.WithField("deployment_id", d.GetID()).Warningf("fuota: %s request reached max. number of attempts, some devices did not complete", name)
+			break
+		}
+
+		for _, devEUI := range devEUIs {
+			log.WithFields(log.Fields{
+				"deployment_id": d.GetID(),
+				"dev_eui":       devEUI,
+				"attempt":       attempt,
+			}).Infof("fuota: %s request for device", name)
+
+			if err := enqueue(ctx, devEUI); err != nil {
+				return err
+			}
+		}
+
+		select {
+		// sleep until next retry
+		case <-time.After(d.opts.UnicastTimeout):
+			continue devLoop
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-done:
+			log.WithField("deployment_id", d.GetID()).Infof("fuota: %s request completed successful for all devices", name)
+			break devLoop
+		}
+	}
+
+	return nil
+}
+
+// Request the firmware and hardware versions of the devices, before the
+// firmware is updated.
+func (d *Deployment) stepDevVersion(ctx context.Context) error {
+	log.WithField("deployment_id", d.GetID()).Info("fuota: requesting device versions from devices")
+
+	if err := d.requestFirmwareManagement(ctx, "device version", d.devVersionDone, func(s *deviceState) bool {
+		return !s.getExcluded() && !s.getDevVersion()
+	}, d.enqueueDevVersionReq); err != nil {
+		return err
+	}
+
+	sd, err := storage.GetDeployment(ctx, storage.DB(), d.GetID())
+	if err != nil {
+		return fmt.Errorf("get deployment error: %w", err)
+	}
+	now := time.Now()
+	sd.DevVersionCompletedAt = &now
+	if err := storage.UpdateDeployment(ctx, storage.DB(), &sd); err != nil {
+		return fmt.Errorf("update deployment error: %w", err)
+	}
+
+	return nil
+}
+
+// Request the devices that completed the transfer to verify the received
+// firmware image.
+func (d *Deployment) stepUpgradeImage(ctx context.Context) error {
+	log.WithField("deployment_id", d.GetID()).Info("fuota: requesting upgrade image verification from devices")
+
+	if err := d.requestFirmwareManagement(ctx, "upgrade image", d.upgradeImageDone, func(s *deviceState) bool {
+		return d.transferCompleted(s) && !s.getUpgradeImage()
+	}, d.enqueueDevUpgradeImageReq); err != nil {
+		return err
+	}
+
+	sd, err := storage.GetDeployment(ctx, storage.DB(), d.GetID())
+	if err != nil {
+		return fmt.Errorf("get deployment error: %w", err)
+	}
+	now := time.Now()
+	sd.UpgradeImageCompletedAt = &now
+	if err := storage.UpdateDeployment(ctx, storage.DB(), &sd); err != nil {
+		return fmt.Errorf("update deployment error: %w", err)
+	}
+
+	return nil
+}
+
+// Schedule the reboot of the devices that verified the firmware image.
+func (d *Deployment) stepDeviceReboot(ctx context.Context) error {
+	log.WithField("deployment_id", d.GetID()).Info("fuota: scheduling reboot of devices")
+
+	if err := d.requestFirmwareManagement(ctx, "device reboot", d.rebootDone, func(s *deviceState) bool {
+		return s.getUpgradeImage() && !s.getReboot()
+	}, d.enqueueDevRebootReq); err != nil {
+		return err
+	}
+
+	sd, err := storage.GetDeployment(ctx, storage.DB(), d.GetID())
+	if err != nil {
+		return fmt.Errorf("get deployment error: %w", err)
+	}
+	now := time.Now()
+	sd.RebootCompletedAt = &now
+	if err := storage.UpdateDeployment(ctx, storage.DB(), &sd); err != nil {
+		return fmt.Errorf("update deployment error: %w", err)
+	}
+
+	return nil
+}
+
+// Wait until the devices have rebooted and request the firmware version,
+// which must match the new firmware version.
+func (d *Deployment) stepFWVersion(ctx context.Context) error {
+	sd, err := storage.GetDeployment(ctx, storage.DB(), d.GetID())
+	if err != nil {
+		return fmt.Errorf("get deployment error: %w", err)
+	}
+
+	timeDiff := time.Until(d.rebootTime(sd))
+	if timeDiff > 0 {
+		log.WithFields(log.Fields{
+			"deployment_id": d.GetID(),
+			"sleep_time":    timeDiff,
+		}).Info("fuota: waiting for devices to reboot before requesting firmware version")
+		if err := sleep(ctx, timeDiff); err != nil {
+			return err
+		}
+	}
+
+	log.WithField("deployment_id", d.GetID()).Info("fuota: requesting firmware version from devices")
+
+	if err := d.requestFirmwareManagement(ctx, "firmware version", d.fwVersionDone, func(s *deviceState) bool {
+		return s.getReboot() && !s.getFWVersion()
+	}, d.enqueueDevVersionReq); err != nil {
+		return err
+	}
+
+	sd, err = storage.GetDeployment(ctx, storage.DB(), d.GetID())
+	if err != nil {
+		return fmt.Errorf("get deployment error: %w", err)
+	}
+	now := time.Now()
+	sd.FWVersionCompletedAt = &now
+	if err := storage.UpdateDeployment(ctx, storage.DB(), &sd); err != nil {
+		return fmt.Errorf("update deployment error: %w", err)
+	}
+
+	return nil
+}
+
+// enqueueDevVersionReq enqueues a DevVersionReq to the given device.
+func (d *Deployment) enqueueDevVersionReq(ctx context.Context, devEUI lorawan.EUI64) error {
+	cmd := firmwaremanagement.Command{
+		CID: firmwaremanagement.DevVersionReq,
+	}
+
+	return d.enqueueFirmwareManagementCommand(ctx, devEUI, cmd, "DevVersionReq", nil)
+}
+
+// enqueueDevUpgradeImageReq enqueues a DevUpgradeImageReq to the given
+// device.
+func (d *Deployment) enqueueDevUpgradeImageReq(ctx context.Context, devEUI lorawan.EUI64) error {
+	cmd := firmwaremanagement.Command{
+		CID: firmwaremanagement.DevUpgradeImageReq,
+	}
+
+	return d.enqueueFirmwareManagementCommand(ctx, devEUI, cmd, "DevUpgradeImageReq", nil)
+}
+
+// enqueueDevRebootReq enqueues a DevRebootTimeReq to the given device when
+// the RebootAt option is set, else a DevRebootCountdownReq.
+func (d *Deployment) enqueueDevRebootReq(ctx context.Context, devEUI lorawan.EUI64) error {
+	if d.opts.RebootAt != nil {
+		rebootTime := uint32((gps.Time(*d.opts.RebootAt).TimeSinceGPSEpoch() / time.Second) % (1 << 32))
+		cmd := firmwaremanagement.Command{
+			CID: firmwaremanagement.DevRebootTimeReq,
+			Payload: &firmwaremanagement.DevRebootTimeReqPayload{
+				RebootTime: rebootTime,
+			},
+		}
+
+		return d.enqueueFirmwareManagementCommand(ctx, devEUI, cmd, "DevRebootTimeReq", map[string]sql.NullString{
+			"reboot_time": sql.NullString{Valid: true, String: fmt.Sprintf("%d", rebootTime)},
+		})
+	}
+
+	countdown := uint32(d.opts.RebootCountdown / time.Second)
+	cmd := firmwaremanagement.Command{
+		CID: firmwaremanagement.DevRebootCountdownReq,
+		Payload: &firmwaremanagement.DevRebootCountdownReqPayload{
+			Countdown: countdown,
+		},
+	}
+
+	return d.enqueueFirmwareManagementCommand(ctx, devEUI, cmd, "DevRebootCountdownReq", map[string]sql.NullString{
+		"countdown": sql.NullString{Valid: true, String: fmt.Sprintf("%d", countdown)},
+	})
+}
+
+// enqueueFirmwareManagementCommand enqueues the given firmware management
+// command to the given device and creates the deployment log.
+func (d *Deployment) enqueueFirmwareManagementCommand(ctx context.Context, devEUI lorawan.EUI64, cmd firmwaremanagement.Command, command string, fields map[string]sql.NullString) error {
+	b, err := cmd.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("marshal binary error: %w", err)
+	}
+
+	_, err = as.DeviceClient().Enqueue(ctx, &api.EnqueueDeviceQueueItemRequest{
+		QueueItem: &api.DeviceQueueItem{
+			DevEui: devEUI.String(),
+			FPort:  uint32(firmwaremanagement.DefaultFPort),
+			Data:   b,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("enqueue payload error: %w", err)
+	}
+
+	dl := storage.DeploymentLog{
+		DeploymentID: d.GetID(),
+		DevEUI:       devEUI,
+		FPort:        firmwaremanagement.DefaultFPort,
+		Command:      command,
+	}
+	if fields != nil {
+		dl.Fields = hstore.Hstore{Map: fields}
+	}
+	d.createDeploymentLog(ctx, EventTypeDeploymentLog, &dl)
+
+	return nil
+}
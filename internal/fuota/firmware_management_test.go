@@ -0,0 +1,191 @@
+package fuota
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/applayer/firmwaremanagement"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/client/as"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/storage"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/test"
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+)
+
+func (s *FUOTATestSuite) TestFirmwareManagement() {
+	assert := require.New(s.T())
+
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	mock := test.NewMockDeviceServiceClient(ctrl)
+	as.SetDeviceClient(mock)
+
+	devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+
+	d, err := NewDeployment(DeploymentOptions{
+		ApplicationID: "app-1",
+		Devices: map[lorawan.EUI64]DeviceOptions{
+			devEUI: {},
+		},
+		MulticastGroupType:                api.MulticastGroupType_CLASS_C,
+		RequestFragmentationSessionStatus: RequestFragmentationSessionStatusNoRequest,
+		FirmwareManagement:                true,
+		FirmwareVersion:                   0x01020000,
+		UnicastTimeout:                    time.Second,
+		UnicastAttemptCount:               1,
+	})
+	assert.NoError(err)
+
+	state := d.deviceState[devEUI]
+
+	// expectRequest expects the given firmware management request and
+	// answers with the given answer.
+	expectRequest := func(t *testing.T, req []byte, ans []byte) {
+		mock.EXPECT().Enqueue(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, r *api.EnqueueDeviceQueueItemRequest, opts ...interface{}) (*api.EnqueueDeviceQueueItemResponse, error) {
+				require.Equal(t, uint32(firmwaremanagement.DefaultFPort), r.GetQueueItem().GetFPort())
+				require.Equal(t, req, r.GetQueueItem().GetData())
+				require.NoError(t, d.handleFirmwareManagementCommand(ctx, devEUI, ans))
+				return &api.EnqueueDeviceQueueItemResponse{}, nil
+			},
+		)
+	}
+
+	s.T().Run("DevVersion", func(t *testing.T) {
+		assert := require.New(t)
+
+		expectRequest(t, []byte{0x01}, []byte{0x01, 0x00, 0x00, 0x01, 0x01, 0x02, 0x00, 0x00, 0x00})
+		assert.NoError(d.stepDevVersion(context.Background()))
+		assert.True(state.getDevVersion())
+
+		dd, err := storage.GetDeploymentDevice(context.Background(), storage.DB(), d.GetID(), devEUI)
+		assert.NoError(err)
+		assert.Equal(uint32(0x01010000), *dd.FWVersionBefore)
+		assert.Equal(uint32(2), *dd.HWVersion)
+	})
+
+	s.T().Run("DevUpgradeImage", func(t *testing.T) {
+		assert := require.New(t)
+
+		// the device has not completed the transfer
+		assert.NoError(d.stepUpgradeImage(context.Background()))
+		assert.False(state.getUpgradeImage())
+
+		state.setMulicastSessionSetup(true)
+
+		t.Run("Invalid image", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(d.handleFirmwareManagementCommand(context.Background(), devEUI, []byte{0x04, 0x01}))
+			assert.False(state.getUpgradeImage())
+
+			dd, err := storage.GetDeploymentDevice(context.Background(), storage.DB(), d.GetID(), devEUI)
+			assert.NoError(err)
+			assert.Equal(storage.FailureReasonUpgradeImageInvalid, dd.FailureReason)
+		})
+
+		t.Run("Version mismatch", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(d.handleFirmwareManagementCommand(context.Background(), devEUI, []byte{0x04, 0x03, 0x00, 0x00, 0x03, 0x01}))
+			assert.False(state.getUpgradeImage())
+
+			dd, err := storage.GetDeploymentDevice(context.Background(), storage.DB(), d.GetID(), devEUI)
+			assert.NoError(err)
+			assert.Equal(storage.FailureReasonUpgradeImageVersionMismatch, dd.FailureReason)
+		})
+
+		expectRequest(t, []byte{0x04}, []byte{0x04, 0x03, 0x00, 0x00, 0x02, 0x01})
+		assert.NoError(d.stepUpgradeImage(context.Background()))
+		assert.True(state.getUpgradeImage())
+
+		dd, err := storage.GetDeploymentDevice(context.Background(), storage.DB(), d.GetID(), devEUI)
+		assert.NoError(err)
+		assert.Equal(uint32(0x01020000), *dd.FWVersionNext)
+		assert.NotNil(dd.UpgradeImageCompletedAt)
+		assert.Equal(storage.FailureReasonNone, dd.FailureReason)
+	})
+
+	s.T().Run("DevReboot", func(t *testing.T) {
+		assert := require.New(t)
+
+		expectRequest(t, []byte{0x03, 0x00, 0x00, 0x00}, []byte{0x03, 0x00, 0x00, 0x00})
+		assert.NoError(d.stepDeviceReboot(context.Background()))
+		assert.True(state.getReboot())
+
+		sd, err := storage.GetDeployment(context.Background(), storage.DB(), d.GetID())
+		assert.NoError(err)
+		assert.NotNil(sd.RebootCompletedAt)
+	})
+
+	s.T().Run("FWVersion", func(t *testing.T) {
+		assert := require.New(t)
+
+		t.Run("Old version", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(d.handleFirmwareManagementCommand(context.Background(), devEUI, []byte{0x01, 0x00, 0x00, 0x01, 0x01, 0x02, 0x00, 0x00, 0x00}))
+			assert.False(state.getFWVersion())
+			assert.Equal(storage.FailureReasonFWVersionNoAnswer, d.deviceNoAnswerReason(devEUI))
+
+			dd, err := storage.GetDeploymentDevice(context.Background(), storage.DB(), d.GetID(), devEUI)
+			assert.NoError(err)
+			assert.Equal(storage.FailureReasonFWVersionMismatch, dd.FailureReason)
+
+			// the version before the deployment must not be overwritten
+			assert.Equal(uint32(0x01010000), *dd.FWVersionBefore)
+		})
+
+		expectRequest(t, []byte{0x01}, []byte{0x01, 0x00, 0x00, 0x02, 0x01, 0x02, 0x00, 0x00, 0x00})
+		assert.NoError(d.stepFWVersion(context.Background()))
+		assert.True(state.getFWVersion())
+		assert.Equal(storage.FailureReasonNone, d.deviceNoAnswerReason(devEUI))
+
+		dd, err := storage.GetDeploymentDevice(context.Background(), storage.DB(), d.GetID(), devEUI)
+		assert.NoError(err)
+		assert.Equal(uint32(0x01020000), *dd.FWVersionAfter)
+		assert.NotNil(dd.FWVersionCompletedAt)
+		assert.Equal(storage.FailureReasonNone, dd.FailureReason)
+	})
+}
+
+func (s *FUOTATestSuite) TestEnqueueDevRebootReq() {
+	rebootAt := time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		opts DeploymentOptions
+		data []byte
+	}{
+		{"countdown", DeploymentOptions{RebootCountdown: 5 * time.Minute}, []byte{0x03, 0x2c, 0x01, 0x00}},
+		{"reboot time", DeploymentOptions{RebootAt: &rebootAt, RebootCountdown: 5 * time.Minute}, []byte{0x02, 0x12, 0x13, 0x87, 0x56}},
+	}
+
+	for _, tst := range tests {
+		s.T().Run(tst.name, func(t *testing.T) {
+			assert := require.New(t)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock := test.NewMockDeviceServiceClient(ctrl)
+			as.SetDeviceClient(mock)
+
+			mock.EXPECT().Enqueue(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, r *api.EnqueueDeviceQueueItemRequest, opts ...interface{}) (*api.EnqueueDeviceQueueItemResponse, error) {
+					assert.Equal(tst.data, r.GetQueueItem().GetData())
+					return &api.EnqueueDeviceQueueItemResponse{}, nil
+				},
+			)
+
+			d, err := NewDeployment(tst.opts)
+			assert.NoError(err)
+			assert.NoError(d.enqueueDevRebootReq(context.Background(), lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}))
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package fuota
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq/hstore"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/applayer/fragmentation"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/client/as"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/storage"
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+)
+
+// ErrMaxFragmentNumber is returned when the repair fragments would exceed the
+// max. fragment number.
+var ErrMaxFragmentNumber = errors.New("max. fragment number exceeded")
+
+// maxFragmentN defines the max. fragment number (the N field is 14 bits).
+const maxFragmentN = (1 << 14) - 1
+
+// Send additional redundancy fragments to the devices that reported missing
+// fragments in their fragmentation-session status. The repair is repeated
+// until all devices have reported a complete fragmentation-session or the
+// number of repair attempts has been reached.
+func (d *Deployment) stepFragmentRepair(ctx context.Context) error {
+	if d.opts.RepairAttemptCount == 0 || d.opts.RequestFragmentationSessionStatus == RequestFragmentationSessionStatusNoRequest {
+		log.WithField("deployment_id", d.GetID()).Info("fuota: skipping fragment repair as requested")
+		return nil
+	}
+
+	log.WithField("deployment_id", d.GetID()).Info("fuota: starting fragment repair for devices")
+
+	attempt := 0
+
+devLoop:
+	for {
+		var devEUIs []lorawan.EUI64
+		for devEUI := range d.opts.Devices {
+			state := d.deviceState[devEUI]
+			if state.getMulticastSessionSetup() && !state.getFragmentationSessionStatus() && state.getMissingFrag() > 0 {
+				devEUIs = append(devEUIs, devEUI)
+			}
+		}
+
+		if len(devEUIs) == 0 {
+			break
+		}
+
+		attempt += 1
+		if attempt > d.opts.RepairAttemptCount {
+			log.WithField("deployment_id", d.GetID()).Warning("fuota: fragment repair reached max. number of attempts, some devices did not complete")
+			break
+		}
+
+		for _, devEUI := range devEUIs {
+			state := d.deviceState[devEUI]
+			missing := state.getMissingFrag()
+
+			log.WithFields(log.Fields{
+				"deployment_id": d.GetID(),
+				"dev_eui":       devEUI,
+				"missing_frag":  missing,
+				"attempt":       attempt,
+			}).Info("fuota: repair missing fragments for device")
+
+			if err := d.enqueueRepairFragments(ctx, devEUI, missing); err != nil {
+				return err
+			}
+
+			// the missing fragments are updated by the next status answer
+			state.setMissingFrag(0)
+
+			if err := d.enqueueFragSessionStatusReq(ctx, devEUI); err != nil {
+				return err
+			}
+		}
+
+		select {
+		// sleep until next retry
+		case <-time.After(d.opts.UnicastTimeout):
+			continue devLoop
+		case <-ctx.Done():
+			return ctx.Err()
+		// re-evaluate the devices when all devices have completed
+		case <-d.fragmentationSessionStatusDone:
+			continue devLoop
+		}
+	}
+
+	sd, err := storage.GetDeployment(ctx, storage.DB(), d.GetID())
+	if err != nil {
+		return fmt.Errorf("get deployment error: %w", err)
+	}
+	now := time.Now()
+	sd.FragRepairCompletedAt = &now
+	if err := storage.UpdateDeployment(ctx, storage.DB(), &sd); err != nil {
+		return fmt.Errorf("update deployment error: %w", err)
+	}
+
+	return nil
+}
+
+// enqueueRepairFragments enqueues the given number of additional redundancy
+// fragments to the given device. The fragment numbers continue after the
+// redundancy fragments of the multicast-session and the repair fragments
+// that were already sent, such that each repair fragment is new to the
+// device.
+func (d *Deployment) enqueueRepairFragments(ctx context.Context, devEUI lorawan.EUI64, count int) error {
+	sd, err := storage.GetDeployment(ctx, storage.DB(), d.GetID())
+	if err != nil {
+		return fmt.Errorf("get deployment error: %w", err)
+	}
+
+	fragments, err := d.encodeFragments(d.opts.Redundancy + sd.RepairFragCount + count)
+	if err != nil {
+		return err
+	}
+	if len(fragments) > maxFragmentN {
+		return ErrMaxFragmentNumber
+	}
+
+	first := len(fragments) - count
+	for i := first; i < len(fragments); i++ {
+		b, err := d.dataFragment(i+1, fragments[i])
+		if err != nil {
+			return err
+		}
+
+		_, err = as.DeviceClient().Enqueue(ctx, &api.EnqueueDeviceQueueItemRequest{
+			QueueItem: &api.DeviceQueueItem{
+				DevEui: devEUI.String(),
+				FPort:  uint32(fragmentation.DefaultFPort),
+				Data:   b,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("enqueue payload error: %w", err)
+		}
+	}
+
+	sd.RepairFragCount += count
+	if err := storage.UpdateDeployment(ctx, storage.DB(), &sd); err != nil {
+		return fmt.Errorf("update deployment error: %w", err)
+	}
+
+	dl := storage.DeploymentLog{
+		DeploymentID: d.GetID(),
+		DevEUI:       devEUI,
+		FPort:        fragmentation.DefaultFPort,
+		Command:      "DataFragment",
+		Fields: hstore.Hstore{
+			Map: map[string]sql.NullString{
+				"frag_index": sql.NullString{Valid: true, String: fmt.Sprintf("%d", d.fragIndex())},
+				"n_first":    sql.NullString{Valid: true, String: fmt.Sprintf("%d", first+1)},
+				"n_last":     sql.NullString{Valid: true, String: fmt.Sprintf("%d", len(fragments))},
+			},
+		},
+	}
+	d.createDeploymentLog(ctx, EventTypeDeploymentLog, &dl)
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package fuota
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/applayer/fragmentation"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/client/as"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/storage"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/test"
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+)
+
+func (s *FUOTATestSuite) TestFragmentRepair() {
+	assert := require.New(s.T())
+
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	mock := test.NewMockDeviceServiceClient(ctrl)
+	as.SetDeviceClient(mock)
+
+	devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+
+	d, err := NewDeployment(DeploymentOptions{
+		ApplicationID: "app-1",
+		Devices: map[lorawan.EUI64]DeviceOptions{
+			devEUI: {},
+		},
+		MulticastGroupType:                api.MulticastGroupType_CLASS_C,
+		FragmentationSessionIndex:         1,
+		FragSize:                          8,
+		Payload:                           make([]byte, 32),
+		Redundancy:                        2,
+		RequestFragmentationSessionStatus: RequestFragmentationSessionStatusAfterFragmentEnqueue,
+		RepairAttemptCount:                2,
+		UnicastTimeout:                    time.Second,
+	})
+	assert.NoError(err)
+
+	state := d.deviceState[devEUI]
+	state.setMulicastSessionSetup(true)
+	state.setMissingFrag(2)
+
+	// the payload is split in 4 fragments + 2 redundancy fragments, the
+	// repair fragments must continue with N=7 and N=8.
+	var fragN []uint16
+	mock.EXPECT().Enqueue(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, r *api.EnqueueDeviceQueueItemRequest, opts ...interface{}) (*api.EnqueueDeviceQueueItemResponse, error) {
+			assert.Equal(uint32(fragmentation.DefaultFPort), r.GetQueueItem().GetFPort())
+
+			var cmd fragmentation.Command
+			assert.NoError(cmd.UnmarshalBinary(false, r.GetQueueItem().GetData()))
+
+			switch cmd.CID {
+			case fragmentation.DataFragment:
+				pl := cmd.Payload.(*fragmentation.DataFragmentPayload)
+				assert.Equal(uint8(1), pl.IndexAndN.FragIndex)
+				fragN = append(fragN, pl.IndexAndN.N)
+			case fragmentation.FragSessionStatusReq:
+				assert.NoError(d.handleFragSessionStatusAns(ctx, devEUI, &fragmentation.FragSessionStatusAnsPayload{
+					ReceivedAndIndex: fragmentation.FragSessionStatusAnsPayloadReceivedAndIndex{
+						FragIndex:      1,
+						NbFragReceived: 6,
+					},
+				}))
+			default:
+				s.T().Fatalf("unexpected command: %s", cmd.CID)
+			}
+
+			return &api.EnqueueDeviceQueueItemResponse{}, nil
+		},
+	).Times(3)
+
+	assert.NoError(d.stepFragmentRepair(context.Background()))
+	assert.Equal([]uint16{7, 8}, fragN)
+	assert.True(state.getFragmentationSessionStatus())
+
+	sd, err := storage.GetDeployment(context.Background(), storage.DB(), d.GetID())
+	assert.NoError(err)
+	assert.Equal(2, sd.RepairFragCount)
+	assert.NotNil(sd.FragRepairCompletedAt)
+}
@@ -0,0 +1,295 @@
+package fuota
+
+import (
+	"context"
+	"crypto/aes"
+	"database/sql"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/jacobsa/crypto/cmac"
+	"github.com/lib/pq/hstore"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/applayer/fragmentation"
+	"github.com/brocaar/lorawan/applayer/multicastsetup"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/client/as"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/storage"
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+)
+
+// Fragmented Data Block Transport package versions.
+const (
+	fragmentationPackageVersion1 uint8 = 1
+	fragmentationPackageVersion2 uint8 = 2
+)
+
+// Fragmented Data Block Transport v2 command identifiers. These are not
+// implemented by the fragmentation package, which implements v1.
+const (
+	fragDataBlockReceivedReq fragmentation.CID = 0x04
+	fragDataBlockReceivedAns fragmentation.CID = 0x04
+)
+
+// fragSessionSetupReqV2Payload implements the v2 FragSessionSetupReq payload,
+// which extends the v1 payload with the session counter and the MIC of the
+// data block.
+type fragSessionSetupReqV2Payload struct {
+	fragmentation.FragSessionSetupReqPayload
+	SessionCnt uint16
+	MIC        [4]byte
+}
+
+// Size returns the payload size in number of bytes.
+func (p fragSessionSetupReqV2Payload) Size() int {
+	return p.FragSessionSetupReqPayload.Size() + 6
+}
+
+// MarshalBinary encodes the payload to a slice of bytes.
+func (p fragSessionSetupReqV2Payload) MarshalBinary() ([]byte, error) {
+	b, err := p.FragSessionSetupReqPayload.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	b = append(b, make([]byte, 2)...)
+	binary.LittleEndian.PutUint16(b[len(b)-2:], p.SessionCnt)
+	b = append(b, p.MIC[:]...)
+
+	return b, nil
+}
+
+// UnmarshalBinary decodes the payload from a slice of bytes.
+func (p *fragSessionSetupReqV2Payload) UnmarshalBinary(data []byte) error {
+	if len(data) < p.Size() {
+		return fmt.Errorf("%d bytes are expected", p.Size())
+	}
+
+	n := p.FragSessionSetupReqPayload.Size()
+	if err := p.FragSessionSetupReqPayload.UnmarshalBinary(data[:n]); err != nil {
+		return err
+	}
+
+	p.SessionCnt = binary.LittleEndian.Uint16(data[n : n+2])
+	copy(p.MIC[:], data[n+2:n+6])
+
+	return nil
+}
+
+// fragDataBlockReceivedPayload implements the FragDataBlockReceivedReq and
+// FragDataBlockReceivedAns payload.
+type fragDataBlockReceivedPayload struct {
+	FragIndex uint8
+}
+
+// Size returns the payload size in number of bytes.
+func (p fragDataBlockReceivedPayload) Size() int {
+	return 1
+}
+
+// MarshalBinary encodes the payload to a slice of bytes.
+func (p fragDataBlockReceivedPayload) MarshalBinary() ([]byte, error) {
+	return []byte{p.FragIndex & 0x03}, nil
+}
+
+// UnmarshalBinary decodes the payload from a slice of bytes.
+func (p *fragDataBlockReceivedPayload) UnmarshalBinary(data []byte) error {
+	if len(data) < p.Size() {
+		return fmt.Errorf("%d bytes are expected", p.Size())
+	}
+
+	p.FragIndex = data[0] & 0x03
+
+	return nil
+}
+
+// getDataBlockIntKey returns the DataBlockIntKey, which is derived from the
+// McKEKey of the device.
+func getDataBlockIntKey(mcRootKey lorawan.AES128Key) (lorawan.AES128Key, error) {
+	var key lorawan.AES128Key
+
+	mcKEKey, err := multicastsetup.GetMcKEKey(mcRootKey)
+	if err != nil {
+		return key, fmt.Errorf("get McKEKey error: %w", err)
+	}
+
+	block, err := aes.NewCipher(mcKEKey[:])
+	if err != nil {
+		return key, err
+	}
+
+	b := make([]byte, 16)
+	b[0] = 0x30
+	block.Encrypt(key[:], b)
+
+	return key, nil
+}
+
+// getDataBlockMIC returns the MIC of the data block, which is used by the
+// device to verify the reconstructed data block. The MIC is calculated as:
+//
+//	B0 = 0x49 | 4 x 0x00 | SessionCnt | FragIndex | Descriptor | len(data)
+//	MIC = aes128_cmac(DataBlockIntKey, B0 | data)[0:4]
+func getDataBlockMIC(key lorawan.AES128Key, sessionCnt uint16, fragIndex uint8, descriptor [4]byte, data []byte) ([4]byte, error) {
+	var mic [4]byte
+
+	b0 := make([]byte, 16)
+	b0[0] = 0x49
+	binary.LittleEndian.PutUint16(b0[5:7], sessionCnt)
+	b0[7] = fragIndex
+	copy(b0[8:12], descriptor[:])
+	binary.LittleEndian.PutUint32(b0[12:16], uint32(len(data)))
+
+	hash, err := cmac.New(key[:])
+	if err != nil {
+		return mic, fmt.Errorf("new cmac error: %w", err)
+	}
+
+	if _, err = hash.Write(b0); err != nil {
+		return mic, fmt.Errorf("hash write error: %w", err)
+	}
+	if _, err = hash.Write(data); err != nil {
+		return mic, fmt.Errorf("hash write error: %w", err)
+	}
+
+	copy(mic[:], hash.Sum(nil))
+
+	return mic, nil
+}
+
+// fragmentationPackageVersion returns the Fragmented Data Block Transport
+// package version used by the deployment.
+func (d *Deployment) fragmentationPackageVersion() uint8 {
+	if d.opts.FragmentationPackageVersion == fragmentationPackageVersion2 {
+		return fragmentationPackageVersion2
+	}
+	return fragmentationPackageVersion1
+}
+
+// fragSessionSetupReqV2Payload returns the v2 FragSessionSetupReq payload for
+// the given device, using the session counter that was assigned to the device
+// on creation of the deployment (incremented for each chunk).
+func (d *Deployment) fragSessionSetupReqV2Payload(ctx context.Context, devEUI lorawan.EUI64, pl fragmentation.FragSessionSetupReqPayload) (*fragSessionSetupReqV2Payload, error) {
+	dd, err := storage.GetDeploymentDevice(ctx, storage.DB(), d.GetID(), devEUI)
+	if err != nil {
+		return nil, fmt.Errorf("get deployment device error: %w", err)
+	}
+
+	key, err := getDataBlockIntKey(d.opts.Devices[devEUI].McRootKey)
+	if err != nil {
+		return nil, fmt.Errorf("get DataBlockIntKey error: %w", err)
+	}
+
+	sessionCnt := uint16(dd.FragSessionCnt)
+	mic, err := getDataBlockMIC(key, sessionCnt, d.fragIndex(), pl.Descriptor, d.chunkPayload())
+	if err != nil {
+		return nil, fmt.Errorf("get data block MIC error: %w", err)
+	}
+
+	return &fragSessionSetupReqV2Payload{
+		FragSessionSetupReqPayload: pl,
+		SessionCnt:                 sessionCnt,
+		MIC:                        mic,
+	}, nil
+}
+
+// handleFragDataBlockReceivedReq handles the FragDataBlockReceivedReq, which
+// is sent by the device when it has reconstructed and verified the data
+// block. This completes the fragmentation-session of the device.
+func (d *Deployment) handleFragDataBlockReceivedReq(ctx context.Context, devEUI lorawan.EUI64, pl *fragDataBlockReceivedPayload) error {
+	log.WithFields(log.Fields{
+		"deployment_id": d.GetID(),
+		"dev_eui":       devEUI,
+		"frag_index":    pl.FragIndex,
+	}).Info("fuota: FragDataBlockReceivedReq received")
+
+	dl := storage.DeploymentLog{
+		DeploymentID: d.GetID(),
+		DevEUI:       devEUI,
+		FPort:        fragmentation.DefaultFPort,
+		Command:      "FragDataBlockReceivedReq",
+		Fields: hstore.Hstore{
+			Map: map[string]sql.NullString{
+				"frag_index": sql.NullString{Valid: true, String: fmt.Sprintf("%d", pl.FragIndex)},
+			},
+		},
+	}
+	d.createDeploymentLog(ctx, EventTypeDeviceAnswer, &dl)
+
+	if pl.FragIndex != d.fragIndex() {
+		return nil
+	}
+
+	// the device repeats the request until it has been acknowledged
+	if err := d.enqueueFragDataBlockReceivedAns(ctx, devEUI); err != nil {
+		return err
+	}
+
+	state, ok := d.deviceState[devEUI]
+	if !ok || state.getFragmentationSessionStatus() {
+		return nil
+	}
+
+	state.setMissingFrag(0)
+	state.setFragmentationSessionStatus(true)
+
+	dd, err := storage.GetDeploymentDevice(ctx, storage.DB(), d.GetID(), devEUI)
+	if err != nil {
+		return fmt.Errorf("get deployment device error: %w", err)
+	}
+	now := time.Now()
+	dd.FragDataBlockReceivedAt = &now
+	dd.FragStatusCompletedAt = &now
+	dd.FailureReason = storage.FailureReasonNone
+	if err := storage.UpdateDeploymentDevice(ctx, storage.DB(), &dd); err != nil {
+		return fmt.Errorf("update deployment device error: %w", err)
+	}
+
+	d.checkFragmentationSessionStatusDone()
+
+	return nil
+}
+
+// enqueueFragDataBlockReceivedAns enqueues the FragDataBlockReceivedAns to the
+// given device.
+func (d *Deployment) enqueueFragDataBlockReceivedAns(ctx context.Context, devEUI lorawan.EUI64) error {
+	cmd := fragmentation.Command{
+		CID: fragDataBlockReceivedAns,
+		Payload: &fragDataBlockReceivedPayload{
+			FragIndex: d.fragIndex(),
+		},
+	}
+
+	b, err := cmd.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("marshal binary error: %w", err)
+	}
+
+	_, err = as.DeviceClient().Enqueue(ctx, &api.EnqueueDeviceQueueItemRequest{
+		QueueItem: &api.DeviceQueueItem{
+			DevEui: devEUI.String(),
+			FPort:  uint32(fragmentation.DefaultFPort),
+			Data:   b,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("enqueue payload error: %w", err)
+	}
+
+	dl := storage.DeploymentLog{
+		DeploymentID: d.GetID(),
+		DevEUI:       devEUI,
+		FPort:        fragmentation.DefaultFPort,
+		Command:      "FragDataBlockReceivedAns",
+		Fields: hstore.Hstore{
+			Map: map[string]sql.NullString{
+				"frag_index": sql.NullString{Valid: true, String: fmt.Sprintf("%d", d.fragIndex())},
+			},
+		},
+	}
+	d.createDeploymentLog(ctx, EventTypeDeploymentLog, &dl)
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package fuota
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/applayer/fragmentation"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/client/as"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/storage"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/test"
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+)
+
+func (s *FUOTATestSuite) TestFragmentationV2() {
+	assert := require.New(s.T())
+
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	mock := test.NewMockDeviceServiceClient(ctrl)
+	as.SetDeviceClient(mock)
+
+	devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+	opts := DeploymentOptions{
+		ApplicationID: "app-1",
+		Devices: map[lorawan.EUI64]DeviceOptions{
+			devEUI: {
+				McRootKey: lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8},
+			},
+		},
+		MulticastGroupType:          api.MulticastGroupType_CLASS_C,
+		FragmentationSessionIndex:   1,
+		FragmentationPackageVersion: 2,
+		FragSize:                    8,
+		Payload:                     make([]byte, 32),
+		UnicastTimeout:              100 * time.Millisecond,
+		UnicastAttemptCount:         1,
+	}
+
+	d1, err := NewDeployment(opts)
+	assert.NoError(err)
+	d2, err := NewDeployment(opts)
+	assert.NoError(err)
+
+	s.T().Run("Session counter", func(t *testing.T) {
+		assert := require.New(t)
+
+		dd1, err := storage.GetDeploymentDevice(context.Background(), storage.DB(), d1.GetID(), devEUI)
+		assert.NoError(err)
+		dd2, err := storage.GetDeploymentDevice(context.Background(), storage.DB(), d2.GetID(), devEUI)
+		assert.NoError(err)
+		assert.Equal(dd1.FragSessionCnt+1, dd2.FragSessionCnt)
+	})
+
+	s.T().Run("FragSessionSetupReq", func(t *testing.T) {
+		assert := require.New(t)
+		d1.deviceState[devEUI].setMulticastSetup(true)
+
+		key, err := getDataBlockIntKey(opts.Devices[devEUI].McRootKey)
+		assert.NoError(err)
+		mic, err := getDataBlockMIC(key, 1, 1, [4]byte{}, opts.Payload)
+		assert.NoError(err)
+
+		mock.EXPECT().Enqueue(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, r *api.EnqueueDeviceQueueItemRequest, opts ...interface{}) (*api.EnqueueDeviceQueueItemResponse, error) {
+				b := r.GetQueueItem().GetData()
+				assert.Equal(fragmentation.FragSessionSetupReq, fragmentation.CID(b[0]))
+
+				var pl fragSessionSetupReqV2Payload
+				assert.NoError(pl.UnmarshalBinary(b[1:]))
+				assert.Equal(uint8(1), pl.FragSession.FragIndex)
+				assert.Equal(uint16(4), pl.NbFrag)
+				assert.Equal(uint16(1), pl.SessionCnt)
+				assert.Equal(mic, pl.MIC)
+
+				return &api.EnqueueDeviceQueueItemResponse{}, nil
+			},
+		)
+
+		assert.NoError(d1.stepFragmentationSessionSetup(context.Background()))
+	})
+
+	s.T().Run("FragDataBlockReceivedReq", func(t *testing.T) {
+		assert := require.New(t)
+		d1.deviceState[devEUI].setMulicastSessionSetup(true)
+
+		mock.EXPECT().Enqueue(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, r *api.EnqueueDeviceQueueItemRequest, opts ...interface{}) (*api.EnqueueDeviceQueueItemResponse, error) {
+				assert.Equal(uint32(fragmentation.DefaultFPort), r.GetQueueItem().GetFPort())
+				assert.Equal([]byte{0x04, 0x01}, r.GetQueueItem().GetData())
+				return &api.EnqueueDeviceQueueItemResponse{}, nil
+			},
+		)
+
+		assert.NoError(d1.handleFragmentationSessionSetupCommand(context.Background(), devEUI, []byte{0x04, 0x01}))
+		assert.True(d1.deviceState[devEUI].getFragmentationSessionStatus())
+
+		select {
+		case <-d1.fragmentationSessionStatusDone:
+		default:
+			t.Fatal("expected fragmentation-session status done")
+		}
+
+		dd, err := storage.GetDeploymentDevice(context.Background(), storage.DB(), d1.GetID(), devEUI)
+		assert.NoError(err)
+		assert.NotNil(dd.FragDataBlockReceivedAt)
+		assert.NotNil(dd.FragStatusCompletedAt)
+	})
+}
+
+func TestFragSessionSetupReqV2Payload(t *testing.T) {
+	assert := require.New(t)
+
+	pl := fragSessionSetupReqV2Payload{
+		FragSessionSetupReqPayload: fragmentation.FragSessionSetupReqPayload{
+			FragSession: fragmentation.FragSessionSetupReqPayloadFragSession{
+				FragIndex:      1,
+				McGroupBitMask: [4]bool{true},
+			},
+			NbFrag:     10,
+			FragSize:   50,
+			Padding:    3,
+			Descriptor: [4]byte{1, 2, 3, 4},
+		},
+		SessionCnt: 258,
+		MIC:        [4]byte{5, 6, 7, 8},
+	}
+
+	b, err := pl.MarshalBinary()
+	assert.NoError(err)
+	assert.Equal([]byte{0x11, 0x0a, 0x00, 0x32, 0x00, 0x03, 0x01, 0x02, 0x03, 0x04, 0x02, 0x01, 0x05, 0x06, 0x07, 0x08}, b)
+
+	var plDecoded fragSessionSetupReqV2Payload
+	assert.NoError(plDecoded.UnmarshalBinary(b))
+	assert.Equal(pl, plDecoded)
+
+	assert.Error(plDecoded.UnmarshalBinary(b[:10]))
+}
+
+func TestGetDataBlockMIC(t *testing.T) {
+	assert := require.New(t)
+
+	key, err := getDataBlockIntKey(lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8})
+	assert.NoError(err)
+
+	mic1, err := getDataBlockMIC(key, 1, 0, [4]byte{}, []byte{1, 2, 3})
+	assert.NoError(err)
+	mic2, err := getDataBlockMIC(key, 2, 0, [4]byte{}, []byte{1, 2, 3})
+	assert.NoError(err)
+	mic3, err := getDataBlockMIC(key, 1, 0, [4]byte{}, []byte{1, 2, 4})
+	assert.NoError(err)
+
+	assert.NotEqual(mic1, mic2)
+	assert.NotEqual(mic1, mic3)
+}
@@ -2,12 +2,14 @@ package fuota
 
 import (
 	"context"
-	"crypto/aes"
 	"crypto/rand"
+	"crypto/sha256"
 	"database/sql"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gofrs/uuid"
@@ -16,6 +18,8 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/applayer/clocksync"
+	"github.com/brocaar/lorawan/applayer/firmwaremanagement"
 	"github.com/brocaar/lorawan/applayer/fragmentation"
 	"github.com/brocaar/lorawan/applayer/multicastsetup"
 	"github.com/brocaar/lorawan/gps"
@@ -37,11 +41,63 @@ const (
 	RequestFragmentationSessionStatusNoRequest            FragmentationSessionStatusRequestType = "NO_REQUEST"
 )
 
+// CompressionType defines the payload compression.
+type CompressionType string
+
+// Compression options.
+const (
+	CompressionNone CompressionType = "NO_COMPRESSION"
+	CompressionLZ4  CompressionType = "LZ4"
+)
+
+// RetryStep defines the step that a device must have completed to be
+// excluded from a retry deployment.
+type RetryStep string
+
+// RetryStep options.
+const (
+	RetryStepFragSessionStatus RetryStep = "FRAG_STATUS"
+	RetryStepMcGroupSetup      RetryStep = "MC_GROUP_SETUP"
+	RetryStepFragSessionSetup  RetryStep = "FRAG_SESSION_SETUP"
+	RetryStepMcSession         RetryStep = "MC_SESSION"
+)
+
+// beaconPeriod defines the Class-B beacon period.
+const beaconPeriod = 128 * time.Second
+
+// Errors returned by the deployment functions.
+var (
+	ErrDeploymentCompleted = errors.New("deployment has already been completed")
+	ErrDeploymentCancelled = errors.New("deployment has already been cancelled")
+	ErrDeploymentRunning   = errors.New("deployment is still running")
+	ErrNoDevicesToRetry    = errors.New("all devices completed the given step")
+	ErrDeploymentUnknown   = errors.New("deployment was created before its options and state were stored")
+	ErrChunkDescriptor     = errors.New("the last byte of the descriptor contains the chunk index, it must not be set and delta payloads can not be split into chunks")
+)
+
+// running contains the deployments that are running within this process.
+var (
+	runningMux sync.Mutex
+	running    = make(map[uuid.UUID]runningDeployment)
+)
+
+type runningDeployment struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
 // Deployments defines the FUOTA deployment struct.
 type Deployment struct {
 	opts DeploymentOptions
 	id   uuid.UUID
 
+	// payload contains the payload as fragmented, after compression
+	payload []byte
+
+	// chunk contains the index of the chunk of the payload that is being
+	// transferred
+	chunk atomic.Int32
+
 	// this contains the ID from CreateMulticastGroupResponse
 	multicastGroupID string
 
@@ -66,6 +122,21 @@ type Deployment struct {
 	// channel for fragmentation-session status
 	fragmentationSessionStatusDone chan struct{}
 
+	// channel for the device cleanup
+	deviceCleanupDone chan struct{}
+
+	// channel for the package versions
+	packageVersionDone chan struct{}
+
+	// channel for the multicast-group status
+	multicastGroupStatusDone chan struct{}
+
+	// channels for the firmware management steps
+	devVersionDone   chan struct{}
+	upgradeImageDone chan struct{}
+	rebootDone       chan struct{}
+	fwVersionDone    chan struct{}
+
 	// session start time
 	// this is set by the multicast-session setup function
 	sessionStartTime time.Time
@@ -100,6 +171,13 @@ type DeploymentOptions struct {
 	// MulticastGroupID defines the multicast group ID.
 	MulticastGroupID uint8
 
+	// AutoMulticastGroupID defines if the multicast group ID must be selected
+	// by the FUOTA server. When set, the multicast-group status is requested
+	// from each device (McGroupStatusReq) and the lowest multicast group ID
+	// that is not active on any of the devices is used, ignoring the
+	// MulticastGroupID option.
+	AutoMulticastGroupID bool
+
 	// MulticastTimeout defines the timeout of the multicast-session.
 	// Please refer to the Remote Multicast Setup specification as this field
 	// has a different meaning for Class-B and Class-C groups.
@@ -125,6 +203,34 @@ type DeploymentOptions struct {
 	// Payload defines the FUOTA payload.
 	Payload []byte
 
+	// FirmwareID contains the ID of the firmware from which the payload was
+	// taken or, in case of a delta payload, derived (optional). The payload
+	// is stored with the deployment, deleting the firmware does not affect
+	// the deployment.
+	FirmwareID *uuid.UUID
+
+	// PayloadSigned defines if the payload has been wrapped in a signed
+	// envelope (see the signing package). The payload is not modified, this
+	// is stored with the deployment for reference.
+	PayloadSigned bool
+
+	// Compression defines the compression applied to the payload before
+	// fragmentation. The compression algorithm is signalled using the 4 most
+	// significant bits of the first byte of the descriptor.
+	Compression CompressionType
+
+	// ChunkSize defines the max. number of payload bytes (after compression)
+	// per fragmentation-session. Larger payloads are split into chunks which
+	// are transferred using consecutive fragmentation-sessions, each using
+	// the next FragIndex (modulo 4), starting at the
+	// FragmentationSessionIndex. The fragmentation-session of a chunk is
+	// deleted before the next chunk is setup. The last byte of the
+	// descriptor contains the chunk index and must not be set, delta payloads
+	// can not be split into chunks. Payloads that would exceed the
+	// max. number of fragments are always split. When not set, the payload is
+	// only split when exceeding the max. number of fragments.
+	ChunkSize int
+
 	// Redundancy (in number of packets).
 	Redundancy int
 
@@ -140,9 +246,78 @@ type DeploymentOptions struct {
 	// Descriptor.
 	Descriptor [4]byte
 
+	// FragmentationPackageVersion defines the Fragmented Data Block Transport
+	// package version (1 or 2) used by the deployment. When not set, version
+	// 1 is used. Version 2 adds the session counter and data block MIC to the
+	// FragSessionSetupReq and lets devices confirm the reconstruction of the
+	// data block using the FragDataBlockReceivedReq.
+	FragmentationPackageVersion uint8
+
+	// MulticastSetupPackageVersion defines the Remote Multicast Setup package
+	// version (1 or 2) used by the deployment. When not set, version 1 is
+	// used. Note that with version 2, the McRootKey of the devices must be
+	// derived from the (LoRaWAN 1.0.x or 1.1.x) AppKey (see
+	// DeriveMcRootKeys). With version 2, the McKey is encrypted using
+	// aes128_encrypt. For both versions, a McGroupSetupAns with IDError is
+	// recorded as failure reason of the device. The multicast-group occupying
+	// the McGroupID is not deleted, as it might be in use by another
+	// multicast context.
+	MulticastSetupPackageVersion uint8
+
 	// RequestFragmentationSessionStatus defines if and when the frag-session
 	// status must be requested.
 	RequestFragmentationSessionStatus FragmentationSessionStatusRequestType
+
+	// RepairAttemptCount defines the max. number of repair rounds for devices
+	// reporting missing fragments in their fragmentation-session status. In
+	// each round, the number of missing fragments is sent as additional
+	// redundancy fragments to the device (unicast), after which the
+	// fragmentation-session status is requested again. A value of 0 disables
+	// the repair. This requires the fragmentation-session status to be
+	// requested.
+	RepairAttemptCount int
+
+	// RequestPackageVersions defines if the package versions must be
+	// requested before the multicast-setup. Devices that do not implement a
+	// compatible Clock Sync, Multicast Setup and Fragmentation (and Firmware
+	// Management when used) package version are excluded from the deployment.
+	RequestPackageVersions bool
+
+	// FirmwareManagement defines if the Firmware Management Protocol must be
+	// used. When set, the firmware version is requested from the devices
+	// (DevVersionReq) before the multicast-setup. After the transfer, the
+	// devices are requested to verify the received image
+	// (DevUpgradeImageReq) and to reboot (DevRebootTimeReq or
+	// DevRebootCountdownReq). A device only completes the deployment when it
+	// reports the new firmware version (DevVersionReq) after the reboot.
+	FirmwareManagement bool
+
+	// FirmwareVersion defines the version of the firmware image. When set,
+	// the version reported by the devices must match. When not set, the
+	// devices must report the version of the image as reported in the
+	// DevUpgradeImageAns.
+	FirmwareVersion uint32
+
+	// RebootAt defines the time at which the devices must reboot into the
+	// new firmware (DevRebootTimeReq). When not set, RebootCountdown is used.
+	RebootAt *time.Time
+
+	// RebootCountdown defines the countdown after which the devices must
+	// reboot into the new firmware (DevRebootCountdownReq).
+	RebootCountdown time.Duration
+
+	// ParentDeploymentID contains the ID of the deployment that this
+	// deployment retries (optional).
+	ParentDeploymentID *uuid.UUID
+
+	// StartAt defines the time at which the deployment is started by the
+	// scheduler. When not set, the deployment is not picked up by the
+	// scheduler and must be started using Start.
+	StartAt *time.Time
+
+	// MaintenanceWindows defines the time windows in which the
+	// multicast-session is allowed to take place (optional).
+	MaintenanceWindows []MaintenanceWindow
 }
 
 // DeviceOptions holds the device options.
@@ -153,13 +328,33 @@ type DeviceOptions struct {
 	McRootKey lorawan.AES128Key
 }
 
+// step defines a step of the deployment.
+type step struct {
+	name      string
+	completed bool
+	f         func(context.Context) error
+}
+
 // deviceState contains the FUOTA state of a device
 type deviceState struct {
 	sync.RWMutex
 	multicastSetup             bool
+	multicastSetupReq          bool
 	fragmentationSessionSetup  bool
+	fragmentationSessionReq    bool
 	multicastSessionSetup      bool
 	fragmentationSessionStatus bool
+	fragmentationSessionDelete bool
+	multicastDelete            bool
+	packageVersions            map[uint8]uint8
+	packageVersion             bool
+	excluded                   bool
+	multicastGroupStatus       bool
+	missingFrag                int
+	devVersion                 bool
+	upgradeImage               bool
+	reboot                     bool
+	fwVersion                  bool
 }
 
 func (d *deviceState) getMulticastSetup() bool {
@@ -174,6 +369,30 @@ func (d *deviceState) setMulticastSetup(done bool) {
 	d.multicastSetup = done
 }
 
+func (d *deviceState) getMulticastSetupReq() bool {
+	d.RLock()
+	defer d.RUnlock()
+	return d.multicastSetupReq
+}
+
+func (d *deviceState) setMulticastSetupReq(done bool) {
+	d.Lock()
+	defer d.Unlock()
+	d.multicastSetupReq = done
+}
+
+func (d *deviceState) getFragmentationSessionReq() bool {
+	d.RLock()
+	defer d.RUnlock()
+	return d.fragmentationSessionReq
+}
+
+func (d *deviceState) setFragmentationSessionReq(done bool) {
+	d.Lock()
+	defer d.Unlock()
+	d.fragmentationSessionReq = done
+}
+
 func (d *deviceState) getFragmentationSessionSetup() bool {
 	d.RLock()
 	defer d.RUnlock()
@@ -186,102 +405,883 @@ func (d *deviceState) setFragmentationSessionSetup(done bool) {
 	d.fragmentationSessionSetup = done
 }
 
-func (d *deviceState) setMulicastSessionSetup(done bool) {
-	d.Lock()
-	defer d.Unlock()
-	d.multicastSessionSetup = done
-}
+func (d *deviceState) setMulicastSessionSetup(done bool) {
+	d.Lock()
+	defer d.Unlock()
+	d.multicastSessionSetup = done
+}
+
+func (d *deviceState) getMulticastSessionSetup() bool {
+	d.RLock()
+	defer d.RUnlock()
+	return d.multicastSessionSetup
+}
+
+func (d *deviceState) setFragmentationSessionStatus(done bool) {
+	d.Lock()
+	defer d.Unlock()
+	d.fragmentationSessionStatus = done
+}
+
+func (d *deviceState) getFragmentationSessionStatus() bool {
+	d.RLock()
+	defer d.RUnlock()
+	return d.fragmentationSessionStatus
+}
+
+func (d *deviceState) setPackageVersion(fPort, version uint8) {
+	d.Lock()
+	defer d.Unlock()
+	if d.packageVersions == nil {
+		d.packageVersions = make(map[uint8]uint8)
+	}
+	d.packageVersions[fPort] = version
+}
+
+func (d *deviceState) getPackageVersion(fPort uint8) (uint8, bool) {
+	d.RLock()
+	defer d.RUnlock()
+	version, ok := d.packageVersions[fPort]
+	return version, ok
+}
+
+func (d *deviceState) setPackageVersionDone(done bool) {
+	d.Lock()
+	defer d.Unlock()
+	d.packageVersion = done
+}
+
+func (d *deviceState) getPackageVersionDone() bool {
+	d.RLock()
+	defer d.RUnlock()
+	return d.packageVersion
+}
+
+func (d *deviceState) setMulticastGroupStatus(done bool) {
+	d.Lock()
+	defer d.Unlock()
+	d.multicastGroupStatus = done
+}
+
+func (d *deviceState) getMulticastGroupStatus() bool {
+	d.RLock()
+	defer d.RUnlock()
+	return d.multicastGroupStatus
+}
+
+func (d *deviceState) setMissingFrag(missing int) {
+	d.Lock()
+	defer d.Unlock()
+	d.missingFrag = missing
+}
+
+func (d *deviceState) getMissingFrag() int {
+	d.RLock()
+	defer d.RUnlock()
+	return d.missingFrag
+}
+
+func (d *deviceState) setExcluded(excluded bool) {
+	d.Lock()
+	defer d.Unlock()
+	d.excluded = excluded
+}
+
+func (d *deviceState) getExcluded() bool {
+	d.RLock()
+	defer d.RUnlock()
+	return d.excluded
+}
+
+func (d *deviceState) setDevVersion(done bool) {
+	d.Lock()
+	defer d.Unlock()
+	d.devVersion = done
+}
+
+func (d *deviceState) getDevVersion() bool {
+	d.RLock()
+	defer d.RUnlock()
+	return d.devVersion
+}
+
+func (d *deviceState) setUpgradeImage(done bool) {
+	d.Lock()
+	defer d.Unlock()
+	d.upgradeImage = done
+}
+
+func (d *deviceState) getUpgradeImage() bool {
+	d.RLock()
+	defer d.RUnlock()
+	return d.upgradeImage
+}
+
+func (d *deviceState) setReboot(done bool) {
+	d.Lock()
+	defer d.Unlock()
+	d.reboot = done
+}
+
+func (d *deviceState) getReboot() bool {
+	d.RLock()
+	defer d.RUnlock()
+	return d.reboot
+}
+
+func (d *deviceState) setFWVersion(done bool) {
+	d.Lock()
+	defer d.Unlock()
+	d.fwVersion = done
+}
+
+func (d *deviceState) getFWVersion() bool {
+	d.RLock()
+	defer d.RUnlock()
+	return d.fwVersion
+}
+
+func (d *deviceState) setFragmentationSessionDelete(done bool) {
+	d.Lock()
+	defer d.Unlock()
+	d.fragmentationSessionDelete = done
+}
+
+func (d *deviceState) getFragmentationSessionDelete() bool {
+	d.RLock()
+	defer d.RUnlock()
+	return d.fragmentationSessionDelete
+}
+
+func (d *deviceState) setMulticastDelete(done bool) {
+	d.Lock()
+	defer d.Unlock()
+	d.multicastDelete = done
+}
+
+func (d *deviceState) getMulticastDelete() bool {
+	d.RLock()
+	defer d.RUnlock()
+	return d.multicastDelete
+}
+
+// pendingFragmentationSessionDelete returns true when the device was
+// requested to setup the fragmentation-session, but did not yet delete it.
+// This includes the devices that did not answer the setup request, as the
+// request might have been received by the device.
+func (d *deviceState) pendingFragmentationSessionDelete() bool {
+	d.RLock()
+	defer d.RUnlock()
+	return (d.fragmentationSessionSetup || d.fragmentationSessionReq) && !d.fragmentationSessionDelete
+}
+
+// pendingMulticastDelete returns true when the device was requested to setup
+// the multicast-group, but did not yet delete it. This includes the devices
+// that did not answer the setup request, as the request might have been
+// received by the device.
+func (d *deviceState) pendingMulticastDelete() bool {
+	d.RLock()
+	defer d.RUnlock()
+	return (d.multicastSetup || d.multicastSetupReq) && !d.multicastDelete
+}
+
+// NewDeployment creates a new Deployment.
+func NewDeployment(opts DeploymentOptions) (*Deployment, error) {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, fmt.Errorf("new uuid error: %w", err)
+	}
+
+	d := newDeployment(id, opts)
+
+	if err := storage.Transaction(func(tx sqlx.Ext) error {
+		return createDeployment(context.Background(), tx, d, nil, 0)
+	}); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// createDeployment stores the given deployment and its devices and
+// maintenance windows. The campaign ID and wave are set for the deployments
+// created by a campaign.
+func createDeployment(ctx context.Context, db sqlx.Ext, d *Deployment, campaignID *uuid.UUID, campaignWave int) error {
+	if err := d.validateChunks(); err != nil {
+		return err
+	}
+
+	opts := d.opts
+	payloadHash := sha256.Sum256(opts.Payload)
+
+	st := storage.Deployment{
+		ID:                                d.id,
+		ApplicationID:                     opts.ApplicationID,
+		MulticastGroupType:                opts.MulticastGroupType.String(),
+		MulticastDR:                       opts.MulticastDR,
+		MulticastPingSlotPeriodicity:      opts.MulticastPingSlotPeriodicity,
+		MulticastFrequency:                opts.MulticastFrequency,
+		MCGroupID:                         opts.MulticastGroupID,
+		MulticastTimeout:                  opts.MulticastTimeout,
+		MulticastRegion:                   opts.MulticastRegion.String(),
+		UnicastTimeout:                    opts.UnicastTimeout,
+		UnicastAttemptCount:               opts.UnicastAttemptCount,
+		FragSize:                          opts.FragSize,
+		Payload:                           opts.Payload,
+		PayloadSize:                       len(opts.Payload),
+		PayloadSHA256:                     payloadHash[:],
+		Compression:                       string(d.compression()),
+		CompressedPayloadSize:             len(d.payload),
+		PayloadSigned:                     opts.PayloadSigned,
+		ChunkSize:                         opts.ChunkSize,
+		ChunkCount:                        d.chunkCount(),
+		Redundancy:                        opts.Redundancy,
+		FragmentationSessionIndex:         opts.FragmentationSessionIndex,
+		FragmentationMatrix:               opts.FragmentationMatrix,
+		BlockAckDelay:                     opts.BlockAckDelay,
+		Descriptor:                        opts.Descriptor[:],
+		RequestFragmentationSessionStatus: string(opts.RequestFragmentationSessionStatus),
+		RequestPackageVersions:            opts.RequestPackageVersions,
+		AutoMCGroupID:                     opts.AutoMulticastGroupID,
+		RepairAttemptCount:                opts.RepairAttemptCount,
+		FragmentationPackageVersion:       d.fragmentationPackageVersion(),
+		MulticastSetupPackageVersion:      d.multicastSetupPackageVersion(),
+		FirmwareManagement:                opts.FirmwareManagement,
+		FirmwareVersion:                   opts.FirmwareVersion,
+		RebootAt:                          opts.RebootAt,
+		RebootCountdown:                   opts.RebootCountdown,
+		FirmwareID:                        opts.FirmwareID,
+		ParentDeploymentID:                opts.ParentDeploymentID,
+		StartAt:                           opts.StartAt,
+		CampaignID:                        campaignID,
+		CampaignWave:                      campaignWave,
+	}
+	if err := storage.CreateDeployment(ctx, db, &st); err != nil {
+		return fmt.Errorf("create deployment error: %w", err)
+	}
+
+	for _, w := range opts.MaintenanceWindows {
+		sw := storage.DeploymentMaintenanceWindow{
+			DeploymentID: d.id,
+			StartAt:      w.Start,
+			EndAt:        w.End,
+		}
+		if err := storage.CreateDeploymentMaintenanceWindow(ctx, db, &sw); err != nil {
+			return fmt.Errorf("create deployment maintenance window error: %w", err)
+		}
+	}
+
+	for devEUI, devOpts := range opts.Devices {
+		d.deviceState[devEUI] = &deviceState{}
+
+		sdd := storage.DeploymentDevice{
+			DeploymentID: d.id,
+			DevEUI:       devEUI,
+			MCRootKey:    storage.EncryptedAES128Key(devOpts.McRootKey),
+		}
+
+		// the session counter must increase for every v2 fragmentation-session
+		// of the device, as the device rejects replayed sessions
+		if d.fragmentationPackageVersion() == fragmentationPackageVersion2 {
+			cnt, err := storage.GetLastFragSessionCnt(ctx, db, devEUI)
+			if err != nil {
+				return fmt.Errorf("get last fragmentation-session counter error: %w", err)
+			}
+			sdd.FragSessionCnt = cnt + 1
+		}
+
+		if err := storage.CreateDeploymentDevice(ctx, db, &sdd); err != nil {
+			return fmt.Errorf("create deployment device error: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// LoadDeployment loads the Deployment with the given ID from the database,
+// including the progress of the deployment and its devices.
+func LoadDeployment(ctx context.Context, id uuid.UUID) (*Deployment, error) {
+	sd, err := storage.GetDeployment(ctx, storage.DB(), id)
+	if err != nil {
+		return nil, fmt.Errorf("get deployment error: %w", err)
+	}
+
+	sdds, err := storage.GetDeploymentDevices(ctx, storage.DB(), id)
+	if err != nil {
+		return nil, fmt.Errorf("get deployment devices error: %w", err)
+	}
+
+	windows, err := storage.GetDeploymentMaintenanceWindows(ctx, storage.DB(), id)
+	if err != nil {
+		return nil, fmt.Errorf("get deployment maintenance windows error: %w", err)
+	}
+
+	d := newDeployment(id, deploymentOptions(sd, sdds, windows))
+	d.multicastGroupID = sd.MulticastGroupID
+	d.mcAddr = sd.MCAddr
+	d.mcKey = lorawan.AES128Key(sd.MCKey)
+	if sd.SessionStartTime != nil {
+		d.sessionStartTime = *sd.SessionStartTime
+	}
+	if sd.SessionEndTime != nil {
+		d.sessionEndTime = *sd.SessionEndTime
+	}
+	d.chunk.Store(int32(sd.ChunksCompleted))
+
+	for _, sdd := range sdds {
+		// devices that did not complete a previous chunk are excluded from
+		// the remaining chunks
+		if sdd.MCGroupSetupCompletedAt != nil && sdd.ChunksCompleted < sd.ChunksCompleted {
+			d.deviceState[sdd.DevEUI] = &deviceState{
+				multicastSetup:       true,
+				packageVersion:       sdd.PackageVersionCompletedAt != nil,
+				multicastGroupStatus: sdd.MCGroupStatusCompletedAt != nil,
+				devVersion:           sdd.FWVersionBefore != nil,
+				excluded:             true,
+			}
+			continue
+		}
+
+		// the setup requests are not stored, assume that these have been
+		// sent to every device that was not excluded
+		excluded := sd.RequestPackageVersions && sd.PackageVersionCompletedAt != nil && sdd.PackageVersionCompletedAt == nil
+
+		d.deviceState[sdd.DevEUI] = &deviceState{
+			multicastSetup:             sdd.MCGroupSetupCompletedAt != nil,
+			multicastSetupReq:          sd.MulticastGroupID != "" && !excluded,
+			fragmentationSessionSetup:  sdd.FragSessionSetupCompletedAt != nil,
+			fragmentationSessionReq:    sd.MCGroupSetupCompletedAt != nil && sdd.MCGroupSetupCompletedAt != nil && !excluded,
+			multicastSessionSetup:      sdd.MCSessionCompletedAt != nil,
+			fragmentationSessionStatus: sdd.FragStatusCompletedAt != nil,
+			packageVersion:             sdd.PackageVersionCompletedAt != nil,
+			multicastGroupStatus:       sdd.MCGroupStatusCompletedAt != nil,
+			devVersion:                 sdd.FWVersionBefore != nil,
+			upgradeImage:               sdd.UpgradeImageCompletedAt != nil,
+			reboot:                     sdd.RebootCompletedAt != nil,
+			fwVersion:                  sdd.FWVersionCompletedAt != nil,
+			excluded:                   excluded,
+		}
+	}
+
+	return d, nil
+}
+
+// RetryDeployment creates a new deployment using the options of the given
+// (ended) deployment. Only the devices that did not complete the given step
+// are included. The returned deployment must be started using Start.
+func RetryDeployment(ctx context.Context, id uuid.UUID, step RetryStep) (*Deployment, error) {
+	sd, err := storage.GetDeployment(ctx, storage.DB(), id)
+	if err != nil {
+		return nil, fmt.Errorf("get deployment error: %w", err)
+	}
+
+	switch sd.State {
+	case storage.DeploymentStatePending, storage.DeploymentStateRunning:
+		return nil, ErrDeploymentRunning
+	case storage.DeploymentStateUnknown:
+		return nil, ErrDeploymentUnknown
+	}
+
+	var completedAt func(storage.DeploymentDevice) *time.Time
+	switch step {
+	case RetryStepFragSessionStatus:
+		completedAt = func(sdd storage.DeploymentDevice) *time.Time { return sdd.FragStatusCompletedAt }
+	case RetryStepMcGroupSetup:
+		completedAt = func(sdd storage.DeploymentDevice) *time.Time { return sdd.MCGroupSetupCompletedAt }
+	case RetryStepFragSessionSetup:
+		completedAt = func(sdd storage.DeploymentDevice) *time.Time { return sdd.FragSessionSetupCompletedAt }
+	case RetryStepMcSession:
+		completedAt = func(sdd storage.DeploymentDevice) *time.Time { return sdd.MCSessionCompletedAt }
+	default:
+		return nil, fmt.Errorf("invalid retry step: %s", step)
+	}
+
+	sdds, err := storage.GetDeploymentDevices(ctx, storage.DB(), id)
+	if err != nil {
+		return nil, fmt.Errorf("get deployment devices error: %w", err)
+	}
+
+	windows, err := storage.GetDeploymentMaintenanceWindows(ctx, storage.DB(), id)
+	if err != nil {
+		return nil, fmt.Errorf("get deployment maintenance windows error: %w", err)
+	}
+
+	var retry []storage.DeploymentDevice
+	for _, sdd := range sdds {
+		if completedAt(sdd) == nil {
+			retry = append(retry, sdd)
+		}
+	}
+
+	if len(retry) == 0 {
+		return nil, ErrNoDevicesToRetry
+	}
+
+	now := time.Now()
+	opts := deploymentOptions(sd, retry, windows)
+	opts.ParentDeploymentID = &sd.ID
+	opts.StartAt = &now
+
+	d, err := NewDeployment(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	log.WithFields(log.Fields{
+		"deployment_id":        d.GetID(),
+		"parent_deployment_id": sd.ID,
+		"device_count":         len(retry),
+	}).Info("fuota: retry deployment created")
+
+	return d, nil
+}
+
+// deploymentOptions returns the DeploymentOptions for the given stored
+// deployment, devices and maintenance windows.
+func deploymentOptions(sd storage.Deployment, sdds []storage.DeploymentDevice, windows []storage.DeploymentMaintenanceWindow) DeploymentOptions {
+	opts := DeploymentOptions{
+		ApplicationID:                     sd.ApplicationID,
+		Devices:                           make(map[lorawan.EUI64]DeviceOptions),
+		MulticastGroupType:                api.MulticastGroupType(api.MulticastGroupType_value[sd.MulticastGroupType]),
+		MulticastDR:                       sd.MulticastDR,
+		MulticastPingSlotPeriodicity:      sd.MulticastPingSlotPeriodicity,
+		MulticastFrequency:                sd.MulticastFrequency,
+		MulticastGroupID:                  sd.MCGroupID,
+		MulticastTimeout:                  sd.MulticastTimeout,
+		MulticastRegion:                   common.Region(common.Region_value[sd.MulticastRegion]),
+		UnicastTimeout:                    sd.UnicastTimeout,
+		UnicastAttemptCount:               sd.UnicastAttemptCount,
+		FragSize:                          sd.FragSize,
+		Payload:                           sd.Payload,
+		Compression:                       CompressionType(sd.Compression),
+		PayloadSigned:                     sd.PayloadSigned,
+		ChunkSize:                         sd.ChunkSize,
+		Redundancy:                        sd.Redundancy,
+		FragmentationSessionIndex:         sd.FragmentationSessionIndex,
+		FragmentationMatrix:               sd.FragmentationMatrix,
+		BlockAckDelay:                     sd.BlockAckDelay,
+		RequestFragmentationSessionStatus: FragmentationSessionStatusRequestType(sd.RequestFragmentationSessionStatus),
+		RequestPackageVersions:            sd.RequestPackageVersions,
+		AutoMulticastGroupID:              sd.AutoMCGroupID,
+		RepairAttemptCount:                sd.RepairAttemptCount,
+		FragmentationPackageVersion:       sd.FragmentationPackageVersion,
+		MulticastSetupPackageVersion:      sd.MulticastSetupPackageVersion,
+		FirmwareManagement:                sd.FirmwareManagement,
+		FirmwareVersion:                   sd.FirmwareVersion,
+		RebootAt:                          sd.RebootAt,
+		RebootCountdown:                   sd.RebootCountdown,
+		FirmwareID:                        sd.FirmwareID,
+		ParentDeploymentID:                sd.ParentDeploymentID,
+		StartAt:                           sd.StartAt,
+	}
+	copy(opts.Descriptor[:], sd.Descriptor)
+
+	for _, w := range windows {
+		opts.MaintenanceWindows = append(opts.MaintenanceWindows, MaintenanceWindow{
+			Start: w.StartAt,
+			End:   w.EndAt,
+		})
+	}
+
+	for _, sdd := range sdds {
+		opts.Devices[sdd.DevEUI] = DeviceOptions{
+			McRootKey: lorawan.AES128Key(sdd.MCRootKey),
+		}
+	}
+
+	return opts
+}
+
+// ResumeDeployments loads the deployments that did not complete (e.g. because
+// of a server restart) and continues running them from the last completed step.
+func ResumeDeployments(ctx context.Context) error {
+	ids, err := storage.GetUnfinishedDeploymentIDs(ctx, storage.DB())
+	if err != nil {
+		return fmt.Errorf("get unfinished deployment ids error: %w", err)
+	}
+
+	for _, id := range ids {
+		depl, err := LoadDeployment(ctx, id)
+		if err != nil {
+			log.WithError(err).WithField("deployment_id", id).Error("fuota: load deployment error")
+			continue
+		}
+
+		log.WithField("deployment_id", id).Info("fuota: resuming deployment")
+
+		Start(depl)
+	}
+
+	return nil
+}
+
+// IsRunning returns true when the deployment with the given ID is running
+// within this process.
+func IsRunning(id uuid.UUID) bool {
+	runningMux.Lock()
+	defer runningMux.Unlock()
+
+	_, ok := running[id]
+	return ok
+}
+
+// Start runs the given deployment in the background. A running deployment
+// can be cancelled using CancelDeployment. Calling Start for a deployment
+// that is already running is a no-op.
+func Start(d *Deployment) {
+	ctx, cancel := context.WithCancel(context.Background())
+	rd := runningDeployment{
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	runningMux.Lock()
+	if _, ok := running[d.GetID()]; ok {
+		runningMux.Unlock()
+		cancel()
+		return
+	}
+	running[d.GetID()] = rd
+	runningMux.Unlock()
+
+	go func() {
+		defer func() {
+			runningMux.Lock()
+			delete(running, d.GetID())
+			runningMux.Unlock()
+
+			cancel()
+			close(rd.done)
+		}()
+
+		if err := d.Run(ctx); err != nil {
+			log.WithError(err).WithField("deployment_id", d.GetID()).Error("fuota: fuota deployment error")
+		}
+	}()
+}
+
+// CancelDeployment cancels the deployment with the given ID. Devices that
+// were sent the multicast-group or fragmentation-session setup are requested
+// to delete these and the multicast-group is removed from ChirpStack. This
+// returns once the cleanup has been performed.
+func CancelDeployment(ctx context.Context, id uuid.UUID) error {
+	sd, err := storage.GetDeployment(ctx, storage.DB(), id)
+	if err != nil {
+		return fmt.Errorf("get deployment error: %w", err)
+	}
+
+	switch sd.State {
+	case storage.DeploymentStateCancelled:
+		return ErrDeploymentCancelled
+	case storage.DeploymentStateCompleted, storage.DeploymentStatePartiallyCompleted:
+		return ErrDeploymentCompleted
+	case storage.DeploymentStateUnknown:
+		return ErrDeploymentUnknown
+	}
+
+	runningMux.Lock()
+	rd, ok := running[id]
+	runningMux.Unlock()
+
+	// The deployment is not running within this process (e.g. it failed),
+	// in which case the cleanup is performed directly.
+	if !ok {
+		d, err := LoadDeployment(ctx, id)
+		if err != nil {
+			return fmt.Errorf("load deployment error: %w", err)
+		}
+
+		return d.cleanupCancelled(ctx)
+	}
+
+	rd.cancel()
+
+	select {
+	case <-rd.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	sd, err = storage.GetDeployment(ctx, storage.DB(), id)
+	if err != nil {
+		return fmt.Errorf("get deployment error: %w", err)
+	}
+	if sd.State != storage.DeploymentStateCancelled {
+		return errors.New("deployment did not complete cleanup, see logs")
+	}
+
+	return nil
+}
+
+func newDeployment(id uuid.UUID, opts DeploymentOptions) *Deployment {
+	opts.Descriptor[0] |= compressionDescriptor(opts.Compression)
+
+	return &Deployment{
+		id:          id,
+		opts:        opts,
+		payload:     compressPayload(opts.Compression, opts.Payload),
+		deviceState: make(map[lorawan.EUI64]*deviceState),
+
+		multicastSetupDone:             make(chan struct{}),
+		fragmentationSessionSetupDone:  make(chan struct{}),
+		multicastSessionSetupDone:      make(chan struct{}),
+		fragmentationSessionStatusDone: make(chan struct{}, 1),
+		deviceCleanupDone:              make(chan struct{}, 1),
+		packageVersionDone:             make(chan struct{}, 1),
+		multicastGroupStatusDone:       make(chan struct{}, 1),
+		devVersionDone:                 make(chan struct{}, 1),
+		upgradeImageDone:               make(chan struct{}, 1),
+		rebootDone:                     make(chan struct{}, 1),
+		fwVersionDone:                  make(chan struct{}, 1),
+	}
+}
+
+// GetID returns the random assigned FUOTA deployment ID.
+func (d *Deployment) GetID() uuid.UUID {
+	return d.id
+}
+
+// Run starts the FUOTA update.
+// Steps that have already been completed (e.g. when the deployment was loaded
+// using LoadDeployment) are skipped.
+func (d *Deployment) Run(ctx context.Context) error {
+	eventhandler.Get().RegisterUplinkEventFunc(d.GetID(), d.HandleUplinkEvent)
+	defer eventhandler.Get().UnregisterUplinkEventFunc(d.GetID())
+
+	if err := d.setState(ctx, storage.DeploymentStateRunning, ""); err != nil {
+		return err
+	}
+
+	sd, err := storage.GetDeployment(ctx, storage.DB(), d.GetID())
+	if err != nil {
+		return fmt.Errorf("get deployment error: %w", err)
+	}
+
+	steps := []step{
+		{"package_version", !d.opts.RequestPackageVersions || sd.PackageVersionCompletedAt != nil, d.stepPackageVersion},
+		{"dev_version", !d.opts.FirmwareManagement || sd.DevVersionCompletedAt != nil, d.stepDevVersion},
+		{"multicast_group_status", !d.opts.AutoMulticastGroupID || sd.MCGroupStatusCompletedAt != nil, d.stepMulticastGroupStatus},
+		{"create_multicast_group", sd.MulticastGroupID != "", d.stepCreateMulticastGroup},
+		{"add_devices_to_multicast_group", sd.MCGroupDevicesAddedAt != nil, d.stepAddDevicesToMulticastGroup},
+		{"multicast_setup", sd.MCGroupSetupCompletedAt != nil, d.stepMulticastSetup},
+	}
+	if ok, err := d.runSteps(ctx, steps); !ok {
+		return err
+	}
+
+	// the steps of the fragmentation-session are repeated for each chunk of
+	// the payload
+	for {
+		sd, err = storage.GetDeployment(ctx, storage.DB(), d.GetID())
+		if err != nil {
+			return fmt.Errorf("get deployment error: %w", err)
+		}
+		last := int(d.chunk.Load()) >= d.chunkCount()-1
+
+		steps = []step{
+			{"fragmentation_session_setup", sd.FragSessionSetupCompletedAt != nil, d.stepFragmentationSessionSetup},
+			{"wait_for_maintenance_window", sd.MCSessionCompletedAt != nil, d.stepWaitForMaintenanceWindow},
+			{"multicast_class_b_session_setup", sd.MCSessionCompletedAt != nil, d.stepMulticastClassBSessionSetup},
+			{"multicast_class_c_session_setup", sd.MCSessionCompletedAt != nil, d.stepMulticastClassCSessionSetup},
+			{"enqueue", sd.EnqueueCompletedAt != nil, d.stepEnqueue},
+			{"fragmentation_session_status", sd.FragStatusCompletedAt != nil, d.stepFragSessionStatus},
+			{"fragment_repair", sd.FragRepairCompletedAt != nil, d.stepFragmentRepair},
+			{"wait_until_timeout", false, d.stepWaitUntilTimeout},
+			{"next_chunk", last, d.stepNextChunk},
+		}
+		if ok, err := d.runSteps(ctx, steps); !ok {
+			return err
+		}
+
+		if last {
+			break
+		}
+	}
+
+	steps = []step{
+		{"upgrade_image", !d.opts.FirmwareManagement || sd.UpgradeImageCompletedAt != nil, d.stepUpgradeImage},
+		{"device_cleanup", sd.DeviceCleanupCompletedAt != nil, d.stepDeviceCleanup},
+		{"device_reboot", !d.opts.FirmwareManagement || sd.RebootCompletedAt != nil, d.stepDeviceReboot},
+		{"fw_version", !d.opts.FirmwareManagement || sd.FWVersionCompletedAt != nil, d.stepFWVersion},
+		{"delete_multicast_group", sd.MCGroupDeletedAt != nil, d.stepDeleteMulticastGroup},
+	}
+	if ok, err := d.runSteps(ctx, steps); !ok {
+		return err
+	}
+
+	if err := d.setDeviceStates(ctx); err != nil {
+		return err
+	}
+
+	sd, err = storage.GetDeployment(ctx, storage.DB(), d.GetID())
+	if err != nil {
+		return fmt.Errorf("get deployment error: %w", err)
+	}
+	now := time.Now()
+	sd.CompletedAt = &now
+	sd.ChunksCompleted = d.chunkCount()
+	sd.MCKey = storage.EncryptedAES128Key{}
+	sd.State = storage.DeploymentStateCompleted
+	if !d.allDevicesCompleted() {
+		sd.State = storage.DeploymentStatePartiallyCompleted
+	}
+	if err := storage.UpdateDeployment(ctx, storage.DB(), &sd); err != nil {
+		return fmt.Errorf("update deployment error: %w", err)
+	}
+
+	log.WithFields(log.Fields{
+		"deployment_id": d.GetID(),
+		"state":         sd.State,
+	}).Info("fuota: deployment completed")
+
+	publish(Event{Type: EventTypeDeploymentEnded, DeploymentID: d.GetID(), State: sd.State})
 
-func (d *deviceState) getMulticastSessionSetup() bool {
-	d.RLock()
-	defer d.RUnlock()
-	return d.multicastSessionSetup
+	return nil
 }
 
-func (d *deviceState) setFragmentationSessionStatus(done bool) {
-	d.Lock()
-	defer d.Unlock()
-	d.fragmentationSessionStatus = done
-}
+// runSteps runs the given steps, skipping the steps that have already been
+// completed. It returns false when the deployment failed (returning the
+// error) or has been cancelled (returning the cleanup error).
+func (d *Deployment) runSteps(ctx context.Context, steps []step) (bool, error) {
+	for _, s := range steps {
+		if s.completed {
+			continue
+		}
 
-func (d *deviceState) getFragmentationSessionStatus() bool {
-	d.RLock()
-	defer d.RUnlock()
-	return d.fragmentationSessionStatus
+		publish(Event{Type: EventTypeStepStarted, DeploymentID: d.GetID(), Step: s.name})
+
+		if err := s.f(ctx); err != nil && ctx.Err() == nil {
+			if err := d.setState(ctx, storage.DeploymentStateFailed, err.Error()); err != nil {
+				log.WithError(err).WithField("deployment_id", d.GetID()).Error("fuota: set deployment state error")
+			}
+			publish(Event{
+				Type:         EventTypeDeploymentEnded,
+				DeploymentID: d.GetID(),
+				State:        storage.DeploymentStateFailed,
+				ErrorMessage: err.Error(),
+			})
+			return false, err
+		}
+
+		if ctx.Err() != nil {
+			log.WithField("deployment_id", d.GetID()).Info("fuota: deployment cancelled")
+
+			// the cleanup must not be aborted by the cancelled context
+			return false, d.cleanupCancelled(context.Background())
+		}
+
+		publish(Event{Type: EventTypeStepFinished, DeploymentID: d.GetID(), Step: s.name})
+	}
+
+	return true, nil
 }
 
-// NewDeployment creates a new Deployment.
-func NewDeployment(opts DeploymentOptions) (*Deployment, error) {
-	id, err := uuid.NewV4()
+// setState persists the given deployment state and error message.
+func (d *Deployment) setState(ctx context.Context, state storage.DeploymentState, errorMessage string) error {
+	sd, err := storage.GetDeployment(ctx, storage.DB(), d.GetID())
 	if err != nil {
-		return nil, fmt.Errorf("new uuid error: %w", err)
+		return fmt.Errorf("get deployment error: %w", err)
 	}
+	sd.State = state
+	sd.ErrorMessage = errorMessage
 
-	d := Deployment{
-		id:          id,
-		opts:        opts,
-		deviceState: make(map[lorawan.EUI64]*deviceState),
-
-		multicastSetupDone:             make(chan struct{}),
-		fragmentationSessionSetupDone:  make(chan struct{}),
-		multicastSessionSetupDone:      make(chan struct{}),
-		fragmentationSessionStatusDone: make(chan struct{}),
+	// the multicast key is not needed once the deployment has ended
+	if state == storage.DeploymentStateFailed {
+		sd.MCKey = storage.EncryptedAES128Key{}
+	}
+	if err := storage.UpdateDeployment(ctx, storage.DB(), &sd); err != nil {
+		return fmt.Errorf("update deployment error: %w", err)
 	}
 
-	if err := storage.Transaction(func(tx sqlx.Ext) error {
-		st := storage.Deployment{
-			ID: d.id,
-		}
-		if err := storage.CreateDeployment(context.Background(), tx, &st); err != nil {
-			return fmt.Errorf("create deployment error: %w", err)
+	return nil
+}
+
+// allDevicesCompleted returns true when all devices completed the deployment.
+func (d *Deployment) allDevicesCompleted() bool {
+	for devEUI := range d.opts.Devices {
+		if d.deviceNoAnswerReason(devEUI) != storage.FailureReasonNone {
+			return false
 		}
+	}
 
-		for devEUI, _ := range opts.Devices {
-			d.deviceState[devEUI] = &deviceState{}
+	return true
+}
 
-			sdd := storage.DeploymentDevice{
-				DeploymentID: d.id,
-				DevEUI:       devEUI,
-			}
-			if err := storage.CreateDeploymentDevice(context.Background(), tx, &sdd); err != nil {
-				return fmt.Errorf("create deployment device error: %w", err)
-			}
-		}
+// deviceNoAnswerReason returns the failure reason for the first step that
+// the device did not complete or FailureReasonNone when the device completed
+// the deployment. In case the fragmentation-session status is not requested,
+// the multicast-session setup is the last step of the transfer. When firmware
+// management is used, the device must also report the new firmware version.
+func (d *Deployment) deviceNoAnswerReason(devEUI lorawan.EUI64) storage.DeploymentDeviceFailureReason {
+	state, ok := d.deviceState[devEUI]
+	if !ok {
+		return storage.FailureReasonMcGroupSetupNoAnswer
+	}
 
-		return nil
-	}); err != nil {
-		return nil, err
+	switch {
+	case state.getExcluded():
+		return storage.FailureReasonPackageVersionNoAnswer
+	case !state.getMulticastSetup():
+		return storage.FailureReasonMcGroupSetupNoAnswer
+	case !state.getFragmentationSessionSetup():
+		return storage.FailureReasonFragSessionSetupNoAnswer
+	case !state.getMulticastSessionSetup():
+		return storage.FailureReasonMcSessionNoAnswer
+	case d.opts.RequestFragmentationSessionStatus != RequestFragmentationSessionStatusNoRequest && !state.getFragmentationSessionStatus():
+		return storage.FailureReasonFragSessionStatusNoAnswer
+	case d.opts.FirmwareManagement && !state.getUpgradeImage():
+		return storage.FailureReasonUpgradeImageNoAnswer
+	case d.opts.FirmwareManagement && !state.getReboot():
+		return storage.FailureReasonRebootNoAnswer
+	case d.opts.FirmwareManagement && !state.getFWVersion():
+		return storage.FailureReasonFWVersionNoAnswer
 	}
 
-	return &d, nil
+	return storage.FailureReasonNone
 }
 
-// GetID returns the random assigned FUOTA deployment ID.
-func (d *Deployment) GetID() uuid.UUID {
-	return d.id
+// setDeviceFailureReason persists the failure reason for the given device.
+// The final device state is set once the deployment has completed.
+func (d *Deployment) setDeviceFailureReason(ctx context.Context, devEUI lorawan.EUI64, reason storage.DeploymentDeviceFailureReason) error {
+	dd, err := storage.GetDeploymentDevice(ctx, storage.DB(), d.GetID(), devEUI)
+	if err != nil {
+		return fmt.Errorf("get deployment device error: %w", err)
+	}
+	dd.FailureReason = reason
+	if err := storage.UpdateDeploymentDevice(ctx, storage.DB(), &dd); err != nil {
+		return fmt.Errorf("update deployment device error: %w", err)
+	}
+
+	return nil
 }
 
-// Run starts the FUOTA update.
-func (d *Deployment) Run(ctx context.Context) error {
-	eventhandler.Get().RegisterUplinkEventFunc(d.GetID(), d.HandleUplinkEvent)
-	defer eventhandler.Get().UnregisterUplinkEventFunc(d.GetID())
+// setDeviceStates persists the final state of each device. For failed
+// devices without a failure reason (reported by the device), the reason is
+// set to the first step for which no answer was received. Devices that
+// completed the transfer of the last chunk completed all chunks.
+func (d *Deployment) setDeviceStates(ctx context.Context) error {
+	for devEUI := range d.opts.Devices {
+		dd, err := storage.GetDeploymentDevice(ctx, storage.DB(), d.GetID(), devEUI)
+		if err != nil {
+			return fmt.Errorf("get deployment device error: %w", err)
+		}
 
-	steps := []func(context.Context) error{
-		d.stepCreateMulticastGroup,
-		d.stepAddDevicesToMulticastGroup,
-		d.stepMulticastSetup,
-		d.stepFragmentationSessionSetup,
-		d.stepMulticastClassBSessionSetup,
-		d.stepMulticastClassCSessionSetup,
-		d.stepEnqueue,
-		d.stepFragSessionStatus,
-		d.stepWaitUntilTimeout,
-		d.stepDeleteMulticastGroup,
-	}
-
-	for _, f := range steps {
-		if err := f(ctx); err != nil {
-			return err
+		if state, ok := d.deviceState[devEUI]; ok && !state.getExcluded() && d.transferCompleted(state) {
+			dd.ChunksCompleted = d.chunkCount()
+		}
+
+		if reason := d.deviceNoAnswerReason(devEUI); reason == storage.FailureReasonNone {
+			dd.State = storage.DeploymentDeviceStateCompleted
+			dd.FailureReason = storage.FailureReasonNone
+		} else {
+			dd.State = storage.DeploymentDeviceStateFailed
+			if dd.FailureReason == storage.FailureReasonNone {
+				dd.FailureReason = reason
+			}
+		}
+
+		if err := storage.UpdateDeploymentDevice(ctx, storage.DB(), &dd); err != nil {
+			return fmt.Errorf("update deployment device error: %w", err)
 		}
 	}
 
@@ -306,6 +1306,14 @@ func (d *Deployment) HandleUplinkEvent(ctx context.Context, pl integration.Uplin
 		if err := d.handleFragmentationSessionSetupCommand(ctx, devEUI, pl.Data); err != nil {
 			return fmt.Errorf("handle fragmentation-session setup command error: %w", err)
 		}
+	} else if uint8(pl.FPort) == clocksync.DefaultFPort && found {
+		if err := d.handleClockSyncCommand(ctx, devEUI, pl.Data); err != nil {
+			return fmt.Errorf("handle clocksync command error: %w", err)
+		}
+	} else if uint8(pl.FPort) == firmwaremanagement.DefaultFPort && found {
+		if err := d.handleFirmwareManagementCommand(ctx, devEUI, pl.Data); err != nil {
+			return fmt.Errorf("handle firmware management command error: %w", err)
+		}
 	} else {
 		log.WithFields(log.Fields{
 			"deployment_id": d.id,
@@ -331,6 +1339,18 @@ func (d *Deployment) handleMulticastSetupCommand(ctx context.Context, devEUI lor
 	}).Info("fuota: multicast-setup command received")
 
 	switch cmd.CID {
+	case multicastsetup.PackageVersionAns:
+		pl, ok := cmd.Payload.(*multicastsetup.PackageVersionAnsPayload)
+		if !ok {
+			return fmt.Errorf("expected *multicastsetup.PackageVersionAnsPayload, got: %T", cmd.Payload)
+		}
+		return d.handlePackageVersionAns(ctx, devEUI, multicastsetup.DefaultFPort, pl.PackageIdentifier, pl.PackageVersion)
+	case multicastsetup.McGroupStatusAns:
+		pl, ok := cmd.Payload.(*multicastsetup.McGroupStatusAnsPayload)
+		if !ok {
+			return fmt.Errorf("expected *multicastsetup.McGroupStatusAnsPayload, got: %T", cmd.Payload)
+		}
+		return d.handleMcGroupStatusAns(ctx, devEUI, pl)
 	case multicastsetup.McGroupSetupAns:
 		pl, ok := cmd.Payload.(*multicastsetup.McGroupSetupAnsPayload)
 		if !ok {
@@ -349,6 +1369,12 @@ func (d *Deployment) handleMulticastSetupCommand(ctx context.Context, devEUI lor
 			return fmt.Errorf("expected *multicastsetup.McClassCSessionAnsPayload, got: %T", cmd.Payload)
 		}
 		return d.handleMcClassCSessionAns(ctx, devEUI, pl)
+	case multicastsetup.McGroupDeleteAns:
+		pl, ok := cmd.Payload.(*multicastsetup.McGroupDeleteAnsPayload)
+		if !ok {
+			return fmt.Errorf("expected *multicastsetup.McGroupDeleteAnsPayload, got: %T", cmd.Payload)
+		}
+		return d.handleMcGroupDeleteAns(ctx, devEUI, pl)
 	}
 
 	return nil
@@ -368,6 +1394,12 @@ func (d *Deployment) handleFragmentationSessionSetupCommand(ctx context.Context,
 	}).Info("fuota: fragmentation-session setup command received")
 
 	switch cmd.CID {
+	case fragmentation.PackageVersionAns:
+		pl, ok := cmd.Payload.(*fragmentation.PackageVersionAnsPayload)
+		if !ok {
+			return fmt.Errorf("expected *fragmentation.PackageVersionAnsPayload, got: %T", cmd.Payload)
+		}
+		return d.handlePackageVersionAns(ctx, devEUI, fragmentation.DefaultFPort, pl.PackageIdentifier, pl.PackageVersion)
 	case fragmentation.FragSessionSetupAns:
 		pl, ok := cmd.Payload.(*fragmentation.FragSessionSetupAnsPayload)
 		if !ok {
@@ -380,6 +1412,19 @@ func (d *Deployment) handleFragmentationSessionSetupCommand(ctx context.Context,
 			return fmt.Errorf("expected *fragmentation.FragSessionStatusAnsPayload, got: %T", cmd.Payload)
 		}
 		return d.handleFragSessionStatusAns(ctx, devEUI, pl)
+	case fragmentation.FragSessionDeleteAns:
+		pl, ok := cmd.Payload.(*fragmentation.FragSessionDeleteAnsPayload)
+		if !ok {
+			return fmt.Errorf("expected *fragmentation.FragSessionDeleteAnsPayload, got: %T", cmd.Payload)
+		}
+		return d.handleFragSessionDeleteAns(ctx, devEUI, pl)
+	case fragDataBlockReceivedReq:
+		// the payload is not decoded by the fragmentation package (v1)
+		var pl fragDataBlockReceivedPayload
+		if err := pl.UnmarshalBinary(b[1:]); err != nil {
+			return fmt.Errorf("unmarshal FragDataBlockReceivedReq payload error: %w", err)
+		}
+		return d.handleFragDataBlockReceivedReq(ctx, devEUI, &pl)
 	}
 
 	return nil
@@ -405,9 +1450,7 @@ func (d *Deployment) handleMcGroupSetupAns(ctx context.Context, devEUI lorawan.E
 			},
 		},
 	}
-	if err := storage.CreateDeploymentLog(ctx, storage.DB(), &dl); err != nil {
-		log.WithError(err).Error("fuota: create deployment log error")
-	}
+	d.createDeploymentLog(ctx, EventTypeDeviceAnswer, &dl)
 
 	if pl.McGroupIDHeader.McGroupID == d.opts.MulticastGroupID && !pl.McGroupIDHeader.IDError {
 		// update the device state
@@ -421,6 +1464,7 @@ func (d *Deployment) handleMcGroupSetupAns(ctx context.Context, devEUI lorawan.E
 		}
 		now := time.Now()
 		dd.MCGroupSetupCompletedAt = &now
+		dd.FailureReason = storage.FailureReasonNone
 		if err := storage.UpdateDeploymentDevice(ctx, storage.DB(), &dd); err != nil {
 			return fmt.Errorf("update deployment device error: %w", err)
 		}
@@ -428,13 +1472,15 @@ func (d *Deployment) handleMcGroupSetupAns(ctx context.Context, devEUI lorawan.E
 		// if all devices have finished the multicast-setup, publish to done chan.
 		done := true
 		for _, state := range d.deviceState {
-			if !state.getMulticastSetup() {
+			if !state.getExcluded() && !state.getMulticastSetup() {
 				done = false
 			}
 		}
 		if done {
 			d.multicastSetupDone <- struct{}{}
 		}
+	} else if pl.McGroupIDHeader.McGroupID == d.opts.MulticastGroupID {
+		return d.setDeviceFailureReason(ctx, devEUI, storage.FailureReasonMcGroupSetupIDError)
 	}
 
 	return nil
@@ -466,11 +1512,9 @@ func (d *Deployment) handleFragSessionSetupAns(ctx context.Context, devEUI loraw
 			},
 		},
 	}
-	if err := storage.CreateDeploymentLog(ctx, storage.DB(), &dl); err != nil {
-		log.WithError(err).Error("fuota: create deployment log error")
-	}
+	d.createDeploymentLog(ctx, EventTypeDeviceAnswer, &dl)
 
-	if pl.StatusBitMask.FragIndex == d.opts.FragmentationSessionIndex && (!pl.StatusBitMask.WrongDescriptor && !pl.StatusBitMask.FragSessionIndexNotSupported && !pl.StatusBitMask.NotEnoughMemory && !pl.StatusBitMask.EncodingUnsupported) {
+	if pl.StatusBitMask.FragIndex == d.fragIndex() && (!pl.StatusBitMask.WrongDescriptor && !pl.StatusBitMask.FragSessionIndexNotSupported && !pl.StatusBitMask.NotEnoughMemory && !pl.StatusBitMask.EncodingUnsupported) {
 		// update the device state
 		if state, ok := d.deviceState[devEUI]; ok {
 			state.setFragmentationSessionSetup(true)
@@ -482,6 +1526,7 @@ func (d *Deployment) handleFragSessionSetupAns(ctx context.Context, devEUI loraw
 		}
 		now := time.Now()
 		dd.FragSessionSetupCompletedAt = &now
+		dd.FailureReason = storage.FailureReasonNone
 		if err := storage.UpdateDeploymentDevice(ctx, storage.DB(), &dd); err != nil {
 			return fmt.Errorf("update deployment device error: %w", err)
 		}
@@ -489,8 +1534,9 @@ func (d *Deployment) handleFragSessionSetupAns(ctx context.Context, devEUI loraw
 		// if all devices have finished the fragmentation-session setup, publish to done chan.
 		done := true
 		for _, state := range d.deviceState {
-			// ignore devices that have not setup multicast
-			if !state.getMulticastSetup() {
+			// ignore devices that have not setup multicast or that have been
+			// excluded
+			if state.getExcluded() || !state.getMulticastSetup() {
 				continue
 			}
 
@@ -501,6 +1547,17 @@ func (d *Deployment) handleFragSessionSetupAns(ctx context.Context, devEUI loraw
 		if done {
 			d.fragmentationSessionSetupDone <- struct{}{}
 		}
+	} else if pl.StatusBitMask.FragIndex == d.fragIndex() {
+		reason := storage.FailureReasonFragSessionSetupWrongDescriptor
+		switch {
+		case pl.StatusBitMask.EncodingUnsupported:
+			reason = storage.FailureReasonFragSessionSetupEncodingUnsupported
+		case pl.StatusBitMask.NotEnoughMemory:
+			reason = storage.FailureReasonFragSessionSetupNotEnoughMemory
+		case pl.StatusBitMask.FragSessionIndexNotSupported:
+			reason = storage.FailureReasonFragSessionSetupIndexNotSupported
+		}
+		return d.setDeviceFailureReason(ctx, devEUI, reason)
 	}
 
 	return nil
@@ -530,9 +1587,7 @@ func (d *Deployment) handleMcClassBSessionAns(ctx context.Context, devEUI lorawa
 			},
 		},
 	}
-	if err := storage.CreateDeploymentLog(context.Background(), storage.DB(), &dl); err != nil {
-		log.WithError(err).Error("fuota: create deployment log error")
-	}
+	d.createDeploymentLog(context.Background(), EventTypeDeviceAnswer, &dl)
 
 	if pl.StatusAndMcGroupID.McGroupID == d.opts.MulticastGroupID && (!pl.StatusAndMcGroupID.McGroupUndefined && !pl.StatusAndMcGroupID.FreqError && !pl.StatusAndMcGroupID.DRError) {
 		// update the device state
@@ -546,6 +1601,7 @@ func (d *Deployment) handleMcClassBSessionAns(ctx context.Context, devEUI lorawa
 		}
 		now := time.Now()
 		dd.MCSessionCompletedAt = &now
+		dd.FailureReason = storage.FailureReasonNone
 		if err := storage.UpdateDeploymentDevice(ctx, storage.DB(), &dd); err != nil {
 			return fmt.Errorf("update deployment device error: %w", err)
 		}
@@ -565,6 +1621,15 @@ func (d *Deployment) handleMcClassBSessionAns(ctx context.Context, devEUI lorawa
 		if done {
 			d.multicastSessionSetupDone <- struct{}{}
 		}
+	} else if pl.StatusAndMcGroupID.McGroupID == d.opts.MulticastGroupID {
+		reason := storage.FailureReasonMcSessionMcGroupUndefined
+		switch {
+		case pl.StatusAndMcGroupID.FreqError:
+			reason = storage.FailureReasonMcSessionFreqError
+		case pl.StatusAndMcGroupID.DRError:
+			reason = storage.FailureReasonMcSessionDRError
+		}
+		return d.setDeviceFailureReason(ctx, devEUI, reason)
 	}
 
 	return nil
@@ -594,9 +1659,7 @@ func (d *Deployment) handleMcClassCSessionAns(ctx context.Context, devEUI lorawa
 			},
 		},
 	}
-	if err := storage.CreateDeploymentLog(context.Background(), storage.DB(), &dl); err != nil {
-		log.WithError(err).Error("fuota: create deployment log error")
-	}
+	d.createDeploymentLog(context.Background(), EventTypeDeviceAnswer, &dl)
 
 	if pl.StatusAndMcGroupID.McGroupID == d.opts.MulticastGroupID && (!pl.StatusAndMcGroupID.McGroupUndefined && !pl.StatusAndMcGroupID.FreqError && !pl.StatusAndMcGroupID.DRError) {
 		// update the device state
@@ -610,6 +1673,7 @@ func (d *Deployment) handleMcClassCSessionAns(ctx context.Context, devEUI lorawa
 		}
 		now := time.Now()
 		dd.MCSessionCompletedAt = &now
+		dd.FailureReason = storage.FailureReasonNone
 		if err := storage.UpdateDeploymentDevice(ctx, storage.DB(), &dd); err != nil {
 			return fmt.Errorf("update deployment device error: %w", err)
 		}
@@ -629,75 +1693,395 @@ func (d *Deployment) handleMcClassCSessionAns(ctx context.Context, devEUI lorawa
 		if done {
 			d.multicastSessionSetupDone <- struct{}{}
 		}
+	} else if pl.StatusAndMcGroupID.McGroupID == d.opts.MulticastGroupID {
+		reason := storage.FailureReasonMcSessionMcGroupUndefined
+		switch {
+		case pl.StatusAndMcGroupID.FreqError:
+			reason = storage.FailureReasonMcSessionFreqError
+		case pl.StatusAndMcGroupID.DRError:
+			reason = storage.FailureReasonMcSessionDRError
+		}
+		return d.setDeviceFailureReason(ctx, devEUI, reason)
+	}
+
+	return nil
+}
+
+func (d *Deployment) handleFragSessionStatusAns(ctx context.Context, devEUI lorawan.EUI64, pl *fragmentation.FragSessionStatusAnsPayload) error {
+	log.WithFields(log.Fields{
+		"deployment_id":            d.GetID(),
+		"dev_eui":                  devEUI,
+		"frag_index":               pl.ReceivedAndIndex.FragIndex,
+		"nb_frag_received":         pl.ReceivedAndIndex.NbFragReceived,
+		"missing_frag":             pl.MissingFrag,
+		"not_enough_matrix_memory": pl.Status.NotEnoughMatrixMemory,
+	}).Info("fuota: FragSessionStatusAns received")
+
+	dl := storage.DeploymentLog{
+		DeploymentID: d.GetID(),
+		DevEUI:       devEUI,
+		FPort:        fragmentation.DefaultFPort,
+		Command:      "FragSessionStatusAns",
+		Fields: hstore.Hstore{
+			Map: map[string]sql.NullString{
+				"frag_index":               sql.NullString{Valid: true, String: fmt.Sprintf("%d", pl.ReceivedAndIndex.FragIndex)},
+				"nb_frag_received":         sql.NullString{Valid: true, String: fmt.Sprintf("%d", pl.ReceivedAndIndex.NbFragReceived)},
+				"missing_frag":             sql.NullString{Valid: true, String: fmt.Sprintf("%d", pl.MissingFrag)},
+				"not_enough_matrix_memory": sql.NullString{Valid: true, String: fmt.Sprintf("%t", pl.Status.NotEnoughMatrixMemory)},
+			},
+		},
+	}
+	d.createDeploymentLog(ctx, EventTypeDeviceAnswer, &dl)
+
+	if pl.ReceivedAndIndex.FragIndex == d.fragIndex() && pl.MissingFrag == 0 && !pl.Status.NotEnoughMatrixMemory {
+		// update the device state
+		if state, ok := d.deviceState[devEUI]; ok {
+			state.setMissingFrag(0)
+			state.setFragmentationSessionStatus(true)
+		}
+
+		dd, err := storage.GetDeploymentDevice(ctx, storage.DB(), d.GetID(), devEUI)
+		if err != nil {
+			return fmt.Errorf("get deployment device error: %w", err)
+		}
+		now := time.Now()
+		dd.FragStatusCompletedAt = &now
+		dd.FailureReason = storage.FailureReasonNone
+		if err := storage.UpdateDeploymentDevice(ctx, storage.DB(), &dd); err != nil {
+			return fmt.Errorf("update deployment device error: %w", err)
+		}
+
+		d.checkFragmentationSessionStatusDone()
+	} else if pl.ReceivedAndIndex.FragIndex == d.fragIndex() {
+		reason := storage.FailureReasonFragSessionStatusMissingFrag
+		if pl.Status.NotEnoughMatrixMemory {
+			reason = storage.FailureReasonFragSessionStatusNotEnoughMatrixMemory
+		} else if state, ok := d.deviceState[devEUI]; ok {
+			// the missing fragments can be repaired
+			state.setMissingFrag(int(pl.MissingFrag))
+		}
+		return d.setDeviceFailureReason(ctx, devEUI, reason)
+	}
+
+	return nil
+}
+
+// checkFragmentationSessionStatusDone publishes to the fragmentation-session
+// status done chan when all devices have completed the fragmentation-session.
+func (d *Deployment) checkFragmentationSessionStatusDone() {
+	for _, state := range d.deviceState {
+		// ignore devices that do not have the multicast-session setup
+		if !state.getMulticastSessionSetup() {
+			continue
+		}
+
+		if !state.getFragmentationSessionStatus() {
+			return
+		}
+	}
+
+	// the channel is buffered, do not block when the status is received
+	// after the fragmentation-session status step has completed (e.g. during
+	// the repair)
+	select {
+	case d.fragmentationSessionStatusDone <- struct{}{}:
+	default:
+	}
+}
+
+func (d *Deployment) handleMcGroupDeleteAns(ctx context.Context, devEUI lorawan.EUI64, pl *multicastsetup.McGroupDeleteAnsPayload) error {
+	log.WithFields(log.Fields{
+		"deployment_id":      d.GetID(),
+		"dev_eui":            devEUI,
+		"mc_group_id":        pl.McGroupIDHeader.McGroupID,
+		"mc_group_undefined": pl.McGroupIDHeader.McGroupUndefined,
+	}).Info("fuota: McGroupDeleteAns received")
+
+	dl := storage.DeploymentLog{
+		DeploymentID: d.GetID(),
+		DevEUI:       devEUI,
+		FPort:        multicastsetup.DefaultFPort,
+		Command:      "McGroupDeleteAns",
+		Fields: hstore.Hstore{
+			Map: map[string]sql.NullString{
+				"mc_group_id":        sql.NullString{Valid: true, String: fmt.Sprintf("%d", pl.McGroupIDHeader.McGroupID)},
+				"mc_group_undefined": sql.NullString{Valid: true, String: fmt.Sprintf("%t", pl.McGroupIDHeader.McGroupUndefined)},
+			},
+		},
+	}
+	d.createDeploymentLog(ctx, EventTypeDeviceAnswer, &dl)
+
+	// an undefined multicast-group means that the device no longer has the
+	// multicast-group context, which is the goal of the delete request
+	if pl.McGroupIDHeader.McGroupID == d.opts.MulticastGroupID {
+		if state, ok := d.deviceState[devEUI]; ok {
+			state.setMulticastDelete(true)
+		}
+		d.signalDeviceCleanup()
+	}
+
+	return nil
+}
+
+func (d *Deployment) handleFragSessionDeleteAns(ctx context.Context, devEUI lorawan.EUI64, pl *fragmentation.FragSessionDeleteAnsPayload) error {
+	log.WithFields(log.Fields{
+		"deployment_id":          d.GetID(),
+		"dev_eui":                devEUI,
+		"frag_index":             pl.Status.FragIndex,
+		"session_does_not_exist": pl.Status.SessionDoesNotExist,
+	}).Info("fuota: FragSessionDeleteAns received")
+
+	dl := storage.DeploymentLog{
+		DeploymentID: d.GetID(),
+		DevEUI:       devEUI,
+		FPort:        fragmentation.DefaultFPort,
+		Command:      "FragSessionDeleteAns",
+		Fields: hstore.Hstore{
+			Map: map[string]sql.NullString{
+				"frag_index":             sql.NullString{Valid: true, String: fmt.Sprintf("%d", pl.Status.FragIndex)},
+				"session_does_not_exist": sql.NullString{Valid: true, String: fmt.Sprintf("%t", pl.Status.SessionDoesNotExist)},
+			},
+		},
+	}
+	d.createDeploymentLog(ctx, EventTypeDeviceAnswer, &dl)
+
+	// a session that does not exist means that the device no longer has the
+	// fragmentation-session context, which is the goal of the delete request
+	if pl.Status.FragIndex == d.fragIndex() {
+		if state, ok := d.deviceState[devEUI]; ok {
+			state.setFragmentationSessionDelete(true)
+		}
+		d.signalDeviceCleanup()
+	}
+
+	return nil
+}
+
+// signalDeviceCleanup publishes to the device cleanup chan when a device
+// answered a delete request, after which the cleanup checks if there are
+// pending deletes left.
+func (d *Deployment) signalDeviceCleanup() {
+	// the channel is buffered, do not block when an answer is received after
+	// the cleanup has completed
+	select {
+	case d.deviceCleanupDone <- struct{}{}:
+	default:
+	}
+}
+
+// deviceCleanupPending returns true when a device did not yet delete the
+// fragmentation-session or the multicast-group it was requested to setup.
+func (d *Deployment) deviceCleanupPending() bool {
+	for _, state := range d.deviceState {
+		if state.pendingFragmentationSessionDelete() || state.pendingMulticastDelete() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// cleanupCancelled requests the devices to delete the fragmentation-session
+// and multicast-group they were requested to setup, deletes the multicast-group from
+// ChirpStack and marks the deployment as cancelled.
+func (d *Deployment) cleanupCancelled(ctx context.Context) error {
+	log.WithField("deployment_id", d.GetID()).Info("fuota: cleaning up cancelled deployment")
+
+	if err := d.deleteDeviceSessions(ctx); err != nil {
+		return err
+	}
+
+	sd, err := storage.GetDeployment(ctx, storage.DB(), d.GetID())
+	if err != nil {
+		return fmt.Errorf("get deployment error: %w", err)
+	}
+
+	if d.multicastGroupID != "" && sd.MCGroupDeletedAt == nil {
+		if err := d.stepDeleteMulticastGroup(ctx); err != nil {
+			return err
+		}
+
+		sd, err = storage.GetDeployment(ctx, storage.DB(), d.GetID())
+		if err != nil {
+			return fmt.Errorf("get deployment error: %w", err)
+		}
+	}
+
+	now := time.Now()
+	sd.CancelledAt = &now
+	sd.MCKey = storage.EncryptedAES128Key{}
+	sd.State = storage.DeploymentStateCancelled
+	if err := storage.UpdateDeployment(ctx, storage.DB(), &sd); err != nil {
+		return fmt.Errorf("update deployment error: %w", err)
+	}
+
+	log.WithField("deployment_id", d.GetID()).Info("fuota: deployment cleanup completed")
+
+	publish(Event{Type: EventTypeDeploymentEnded, DeploymentID: d.GetID(), State: sd.State})
+
+	return nil
+}
+
+// enqueueFragSessionDeleteReq enqueues a FragSessionDeleteReq for the
+// fragmentation-session of the deployment to the given device.
+func (d *Deployment) enqueueFragSessionDeleteReq(ctx context.Context, devEUI lorawan.EUI64) error {
+	cmd := fragmentation.Command{
+		CID: fragmentation.FragSessionDeleteReq,
+		Payload: &fragmentation.FragSessionDeleteReqPayload{
+			Param: fragmentation.FragSessionDeleteReqPayloadParam{
+				FragIndex: d.fragIndex(),
+			},
+		},
+	}
+
+	b, err := cmd.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("marshal binary error: %w", err)
+	}
+
+	_, err = as.DeviceClient().Enqueue(ctx, &api.EnqueueDeviceQueueItemRequest{
+		QueueItem: &api.DeviceQueueItem{
+			DevEui: devEUI.String(),
+			FPort:  uint32(fragmentation.DefaultFPort),
+			Data:   b,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("enqueue payload error: %w", err)
 	}
 
+	dl := storage.DeploymentLog{
+		DeploymentID: d.GetID(),
+		DevEUI:       devEUI,
+		FPort:        uint8(fragmentation.DefaultFPort),
+		Command:      "FragSessionDeleteReq",
+		Fields: hstore.Hstore{
+			Map: map[string]sql.NullString{
+				"frag_index": sql.NullString{Valid: true, String: fmt.Sprintf("%d", d.fragIndex())},
+			},
+		},
+	}
+	d.createDeploymentLog(ctx, EventTypeDeploymentLog, &dl)
+
 	return nil
 }
 
-func (d *Deployment) handleFragSessionStatusAns(ctx context.Context, devEUI lorawan.EUI64, pl *fragmentation.FragSessionStatusAnsPayload) error {
-	log.WithFields(log.Fields{
-		"deployment_id":            d.GetID(),
-		"dev_eui":                  devEUI,
-		"frag_index":               pl.ReceivedAndIndex.FragIndex,
-		"nb_frag_received":         pl.ReceivedAndIndex.NbFragReceived,
-		"missing_frag":             pl.MissingFrag,
-		"not_enough_matrix_memory": pl.Status.NotEnoughMatrixMemory,
-	}).Info("fuota: FragSessionStatusAns received")
+// enqueueFragSessionStatusReq enqueues a FragSessionStatusReq for the
+// fragmentation-session of the deployment to the given device.
+func (d *Deployment) enqueueFragSessionStatusReq(ctx context.Context, devEUI lorawan.EUI64) error {
+	cmd := fragmentation.Command{
+		CID: fragmentation.FragSessionStatusReq,
+		Payload: &fragmentation.FragSessionStatusReqPayload{
+			FragStatusReqParam: fragmentation.FragSessionStatusReqPayloadFragStatusReqParam{
+				FragIndex:    d.fragIndex(),
+				Participants: true,
+			},
+		},
+	}
+
+	b, err := cmd.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("marshal binary error: %w", err)
+	}
+
+	_, err = as.DeviceClient().Enqueue(ctx, &api.EnqueueDeviceQueueItemRequest{
+		QueueItem: &api.DeviceQueueItem{
+			DevEui: devEUI.String(),
+			FPort:  uint32(fragmentation.DefaultFPort),
+			Data:   b,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("enqueue payload error: %w", err)
+	}
 
 	dl := storage.DeploymentLog{
 		DeploymentID: d.GetID(),
 		DevEUI:       devEUI,
-		FPort:        fragmentation.DefaultFPort,
-		Command:      "FragSessionStatusAns",
+		FPort:        uint8(fragmentation.DefaultFPort),
+		Command:      "FragSessionStatusReq",
 		Fields: hstore.Hstore{
 			Map: map[string]sql.NullString{
-				"frag_index":               sql.NullString{Valid: true, String: fmt.Sprintf("%d", pl.ReceivedAndIndex.FragIndex)},
-				"nb_frag_received":         sql.NullString{Valid: true, String: fmt.Sprintf("%d", pl.ReceivedAndIndex.NbFragReceived)},
-				"missing_frag":             sql.NullString{Valid: true, String: fmt.Sprintf("%d", pl.MissingFrag)},
-				"not_enough_matrix_memory": sql.NullString{Valid: true, String: fmt.Sprintf("%t", pl.Status.NotEnoughMatrixMemory)},
+				"frag_index":   sql.NullString{Valid: true, String: fmt.Sprintf("%d", d.fragIndex())},
+				"participants": sql.NullString{Valid: true, String: "true"},
 			},
 		},
 	}
-	if err := storage.CreateDeploymentLog(ctx, storage.DB(), &dl); err != nil {
-		log.WithError(err).Error("fuota: create deployment log error")
-	}
+	d.createDeploymentLog(ctx, EventTypeDeploymentLog, &dl)
 
-	if pl.ReceivedAndIndex.FragIndex == d.opts.FragmentationSessionIndex && pl.MissingFrag == 0 && !pl.Status.NotEnoughMatrixMemory {
-		// update the device state
-		if state, ok := d.deviceState[devEUI]; ok {
-			state.setFragmentationSessionStatus(true)
-		}
+	return nil
+}
 
-		dd, err := storage.GetDeploymentDevice(ctx, storage.DB(), d.GetID(), devEUI)
-		if err != nil {
-			return fmt.Errorf("get deployment device error: %w", err)
-		}
-		now := time.Now()
-		dd.FragStatusCompletedAt = &now
-		if err := storage.UpdateDeploymentDevice(ctx, storage.DB(), &dd); err != nil {
-			return fmt.Errorf("update deployment device error: %w", err)
-		}
+// enqueueMcGroupDeleteReq enqueues a McGroupDeleteReq for the multicast-group
+// of the deployment to the given device.
+func (d *Deployment) enqueueMcGroupDeleteReq(ctx context.Context, devEUI lorawan.EUI64) error {
+	cmd := multicastsetup.Command{
+		CID: multicastsetup.McGroupDeleteReq,
+		Payload: &multicastsetup.McGroupDeleteReqPayload{
+			McGroupIDHeader: multicastsetup.McGroupDeleteReqPayloadMcGroupIDHeader{
+				McGroupID: d.opts.MulticastGroupID,
+			},
+		},
+	}
 
-		// if all devices have finished the frag session status, publish to done chan.
-		done := true
-		for _, state := range d.deviceState {
-			// ignore devices that do not have the multicast-session setup
-			if !state.getMulticastSessionSetup() {
-				continue
-			}
+	b, err := cmd.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("marshal binary error: %w", err)
+	}
 
-			if !state.getFragmentationSessionStatus() {
-				done = false
-			}
-		}
-		if done {
-			d.fragmentationSessionStatusDone <- struct{}{}
-		}
+	_, err = as.DeviceClient().Enqueue(ctx, &api.EnqueueDeviceQueueItemRequest{
+		QueueItem: &api.DeviceQueueItem{
+			DevEui: devEUI.String(),
+			FPort:  uint32(multicastsetup.DefaultFPort),
+			Data:   b,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("enqueue payload error: %w", err)
+	}
+
+	dl := storage.DeploymentLog{
+		DeploymentID: d.GetID(),
+		DevEUI:       devEUI,
+		FPort:        uint8(multicastsetup.DefaultFPort),
+		Command:      "McGroupDeleteReq",
+		Fields: hstore.Hstore{
+			Map: map[string]sql.NullString{
+				"mc_group_id": sql.NullString{Valid: true, String: fmt.Sprintf("%d", d.opts.MulticastGroupID)},
+			},
+		},
 	}
+	d.createDeploymentLog(ctx, EventTypeDeploymentLog, &dl)
 
 	return nil
 }
 
+// createDeploymentLog creates the given deployment log and publishes it as
+// an event of the given type. Errors are logged, but not returned as a failing
+// log write must not interrupt the deployment.
+func (d *Deployment) createDeploymentLog(ctx context.Context, eventType EventType, dl *storage.DeploymentLog) {
+	if err := storage.CreateDeploymentLog(ctx, storage.DB(), dl); err != nil {
+		log.WithError(err).Error("fuota: create deployment log error")
+		return
+	}
+
+	publish(Event{
+		Type:         eventType,
+		DeploymentID: d.GetID(),
+		Log:          dl,
+	})
+}
+
+// sleep blocks for the given duration or until the context is cancelled.
+func sleep(ctx context.Context, d time.Duration) error {
+	select {
+	case <-time.After(d):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // create multicast group step
 func (d *Deployment) stepCreateMulticastGroup(ctx context.Context) error {
 	log.WithField("deployment_id", d.GetID()).Debug("fuota: stepCreateMulticastGroup funtion called")
@@ -725,16 +2109,16 @@ func (d *Deployment) stepCreateMulticastGroup(ctx context.Context) error {
 	}
 
 	mg := api.MulticastGroup{
-		Name:                 fmt.Sprintf("fuota-%s", d.GetID()),
-		McAddr:               d.mcAddr.String(),
-		McNwkSKey:            mcNetSKey.String(),
-		McAppSKey:            mcAppSKey.String(),
-		GroupType:            d.opts.MulticastGroupType,
-		Dr:                   uint32(d.opts.MulticastDR),
-		Frequency:            d.opts.MulticastFrequency,
-		ClassBPingSlotPeriodicity: uint32(d.opts.MulticastPingSlotPeriodicity), 
-		ApplicationId:        d.opts.ApplicationID,
-		Region:               d.opts.MulticastRegion,
+		Name:                      fmt.Sprintf("fuota-%s", d.GetID()),
+		McAddr:                    d.mcAddr.String(),
+		McNwkSKey:                 mcNetSKey.String(),
+		McAppSKey:                 mcAppSKey.String(),
+		GroupType:                 d.opts.MulticastGroupType,
+		Dr:                        uint32(d.opts.MulticastDR),
+		Frequency:                 d.opts.MulticastFrequency,
+		ClassBPingSlotPeriodicity: uint32(d.opts.MulticastPingSlotPeriodicity),
+		ApplicationId:             d.opts.ApplicationID,
+		Region:                    d.opts.MulticastRegion,
 	}
 
 	resp, err := as.MulticastGroupClient().Create(ctx, &api.CreateMulticastGroupRequest{
@@ -751,9 +2135,49 @@ func (d *Deployment) stepCreateMulticastGroup(ctx context.Context) error {
 		"multicast_group_id": resp.Id,
 	}).Info("fuota: multicast-group created")
 
+	sd, err := storage.GetDeployment(ctx, storage.DB(), d.GetID())
+	if err != nil {
+		return fmt.Errorf("get deployment error: %w", err)
+	}
+	sd.MulticastGroupID = d.multicastGroupID
+	sd.MCAddr = d.mcAddr
+	sd.MCKey = storage.EncryptedAES128Key(d.mcKey)
+	if err := storage.UpdateDeployment(ctx, storage.DB(), &sd); err != nil {
+		return fmt.Errorf("update deployment error: %w", err)
+	}
+
 	return nil
 }
 
+// multicastSessionStartTime returns the start time of the multicast-session
+// when its setup is initiated at the given time. The devices are given the
+// unicast timeout to receive the session setup. A Class-B session must start
+// at the beginning of a beacon period.
+func (d *Deployment) multicastSessionStartTime(now time.Time) time.Time {
+	start := now.Add(d.opts.UnicastTimeout)
+	if d.opts.MulticastGroupType != api.MulticastGroupType_CLASS_B {
+		return start
+	}
+
+	sinceEpoch := gps.Time(start).TimeSinceGPSEpoch()
+	if rem := sinceEpoch % beaconPeriod; rem != 0 {
+		sinceEpoch += beaconPeriod - rem
+	}
+
+	return time.Time(gps.NewTimeFromTimeSinceGPSEpoch(sinceEpoch))
+}
+
+// multicastSessionDuration returns the duration of the multicast-session.
+// For Class-B the timeout is expressed as 2^TimeOut beacon periods, for
+// Class-C as 2^TimeOut seconds.
+func (d *Deployment) multicastSessionDuration() time.Duration {
+	if d.opts.MulticastGroupType == api.MulticastGroupType_CLASS_B {
+		return time.Duration(1<<d.opts.MulticastTimeout) * beaconPeriod
+	}
+
+	return time.Duration(1<<d.opts.MulticastTimeout) * time.Second
+}
+
 // Wait until multicast-session timeout.
 // This is needed in case the fragmentation-session status request step is skipped.
 // We don't want to cleanup the multicast-group before the multicast-session has
@@ -765,7 +2189,102 @@ func (d *Deployment) stepWaitUntilTimeout(ctx context.Context) error {
 			"deployment_id": d.GetID(),
 			"sleep_time":    timeDiff,
 		}).Info("fuota: waiting for multicast-session to end for devices")
-		time.Sleep(timeDiff)
+		if err := sleep(ctx, timeDiff); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Request the devices to delete the fragmentation-session and the
+// multicast-group, such that the FragIndex and McGroupID can be re-used by
+// the next deployment.
+func (d *Deployment) stepDeviceCleanup(ctx context.Context) error {
+	log.WithField("deployment_id", d.GetID()).Info("fuota: starting device cleanup")
+
+	if err := d.deleteDeviceSessions(ctx); err != nil {
+		return err
+	}
+
+	sd, err := storage.GetDeployment(ctx, storage.DB(), d.GetID())
+	if err != nil {
+		return fmt.Errorf("get deployment error: %w", err)
+	}
+	now := time.Now()
+	sd.DeviceCleanupCompletedAt = &now
+	if err := storage.UpdateDeployment(ctx, storage.DB(), &sd); err != nil {
+		return fmt.Errorf("update deployment error: %w", err)
+	}
+
+	return nil
+}
+
+// deleteDeviceSessions requests the devices to delete the
+// fragmentation-session and the multicast-group they were requested to setup.
+// The requests are retried until all devices have answered or the max. number
+// of unicast attempts has been reached.
+func (d *Deployment) deleteDeviceSessions(ctx context.Context) error {
+	attempt := 0
+
+devLoop:
+	for {
+		attempt += 1
+		if attempt > d.opts.UnicastAttemptCount {
+			log.WithField("deployment_id", d.GetID()).Warning("fuota: device cleanup reached max. number of attempts, some devices did not complete")
+			break
+		}
+
+		pending := false
+
+		for devEUI := range d.opts.Devices {
+			state, ok := d.deviceState[devEUI]
+			if !ok {
+				continue
+			}
+
+			if state.pendingFragmentationSessionDelete() {
+				pending = true
+
+				if err := d.enqueueFragSessionDeleteReq(ctx, devEUI); err != nil {
+					log.WithError(err).WithFields(log.Fields{
+						"deployment_id": d.GetID(),
+						"dev_eui":       devEUI,
+					}).Error("fuota: enqueue FragSessionDeleteReq error")
+				}
+			}
+
+			if state.pendingMulticastDelete() {
+				pending = true
+
+				if err := d.enqueueMcGroupDeleteReq(ctx, devEUI); err != nil {
+					log.WithError(err).WithFields(log.Fields{
+						"deployment_id": d.GetID(),
+						"dev_eui":       devEUI,
+					}).Error("fuota: enqueue McGroupDeleteReq error")
+				}
+			}
+		}
+
+		if !pending {
+			break
+		}
+
+		timeout := time.After(d.opts.UnicastTimeout)
+		for {
+			select {
+			// sleep until next retry
+			case <-timeout:
+				continue devLoop
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-d.deviceCleanupDone:
+				if !d.deviceCleanupPending() {
+					log.WithField("deployment_id", d.GetID()).Info("fuota: device cleanup completed successful for all devices")
+					break devLoop
+				}
+			}
+		}
 	}
 
 	return nil
@@ -787,6 +2306,16 @@ func (d *Deployment) stepDeleteMulticastGroup(ctx context.Context) error {
 		"multicast_group_id": d.multicastGroupID,
 	}).Info("fuota: multicast-group deleted")
 
+	sd, err := storage.GetDeployment(ctx, storage.DB(), d.GetID())
+	if err != nil {
+		return fmt.Errorf("get deployment error: %w", err)
+	}
+	now := time.Now()
+	sd.MCGroupDeletedAt = &now
+	if err := storage.UpdateDeployment(ctx, storage.DB(), &sd); err != nil {
+		return fmt.Errorf("update deployment error: %w", err)
+	}
+
 	return nil
 }
 
@@ -795,6 +2324,11 @@ func (d *Deployment) stepAddDevicesToMulticastGroup(ctx context.Context) error {
 	log.WithField("deployment_id", d.GetID()).Info("fuota: add devices to multicast-group")
 
 	for devEUI := range d.opts.Devices {
+		// ignore devices that have been excluded by the package versions
+		if d.deviceState[devEUI].getExcluded() {
+			continue
+		}
+
 		log.WithFields(log.Fields{
 			"deployment_id":      d.GetID(),
 			"dev_eui":            devEUI,
@@ -810,6 +2344,16 @@ func (d *Deployment) stepAddDevicesToMulticastGroup(ctx context.Context) error {
 		}
 	}
 
+	sd, err := storage.GetDeployment(ctx, storage.DB(), d.GetID())
+	if err != nil {
+		return fmt.Errorf("get deployment error: %w", err)
+	}
+	now := time.Now()
+	sd.MCGroupDevicesAddedAt = &now
+	if err := storage.UpdateDeployment(ctx, storage.DB(), &sd); err != nil {
+		return fmt.Errorf("update deployment error: %w", err)
+	}
+
 	return nil
 }
 
@@ -827,7 +2371,7 @@ devLoop:
 		}
 
 		for devEUI := range d.opts.Devices {
-			if d.deviceState[devEUI].getMulticastSetup() {
+			if d.deviceState[devEUI].getExcluded() || d.deviceState[devEUI].getMulticastSetup() {
 				continue
 			}
 
@@ -838,16 +2382,10 @@ devLoop:
 			}).Info("fuota: initiate multicast-setup for device")
 
 			// get the encrypted McKey.
-			var mcKeyEncrypted lorawan.AES128Key
-			mcKEKey, err := multicastsetup.GetMcKEKey(d.opts.Devices[devEUI].McRootKey)
-			if err != nil {
-				return fmt.Errorf("GetMcKEKey error: %w", err)
-			}
-			block, err := aes.NewCipher(mcKEKey[:])
+			mcKeyEncrypted, err := d.encryptMcKey(d.opts.Devices[devEUI].McRootKey)
 			if err != nil {
-				return fmt.Errorf("new cipher error: %w", err)
+				return err
 			}
-			block.Decrypt(mcKeyEncrypted[:], d.mcKey[:])
 
 			cmd := multicastsetup.Command{
 				CID: multicastsetup.McGroupSetupReq,
@@ -881,6 +2419,7 @@ devLoop:
 				}).Error("fuota: enqueue payload error")
 				continue
 			}
+			d.deviceState[devEUI].setMulticastSetupReq(true)
 
 			dl := storage.DeploymentLog{
 				DeploymentID: d.GetID(),
@@ -897,15 +2436,15 @@ devLoop:
 					},
 				},
 			}
-			if err := storage.CreateDeploymentLog(ctx, storage.DB(), &dl); err != nil {
-				log.WithError(err).Error("fuota: create deployment log error")
-			}
+			d.createDeploymentLog(ctx, EventTypeDeploymentLog, &dl)
 		}
 
 		select {
 		// sleep until next retry
 		case <-time.After(d.opts.UnicastTimeout):
 			continue devLoop
+		case <-ctx.Done():
+			return ctx.Err()
 		// terminate when all devices have been setup
 		case <-d.multicastSetupDone:
 			log.WithField("deployment_id", d.GetID()).Info("fuota: multicast-setup completed successful for all devices")
@@ -930,8 +2469,9 @@ func (d *Deployment) stepFragmentationSessionSetup(ctx context.Context) error {
 	log.WithField("deployment_id", d.GetID()).Info("fuota: starting fragmentation-session setup for devices")
 
 	attempt := 0
-	padding := (d.opts.FragSize - (len(d.opts.Payload) % d.opts.FragSize)) % d.opts.FragSize
-	nbFrag := (len(d.opts.Payload) + padding) / d.opts.FragSize
+	payload := d.chunkPayload()
+	padding := (d.opts.FragSize - (len(payload) % d.opts.FragSize)) % d.opts.FragSize
+	nbFrag := (len(payload) + padding) / d.opts.FragSize
 
 devLoop:
 	for {
@@ -942,8 +2482,9 @@ devLoop:
 		}
 
 		for devEUI := range d.opts.Devices {
-			// ignore devices that have not setup multicast
-			if !d.deviceState[devEUI].getMulticastSetup() {
+			// ignore devices that have not setup multicast or that have been
+			// excluded
+			if d.deviceState[devEUI].getExcluded() || !d.deviceState[devEUI].getMulticastSetup() {
 				continue
 			}
 
@@ -957,22 +2498,34 @@ devLoop:
 				"attempt":       attempt,
 			}).Info("fuota: initiate fragmentation-session setup for device")
 
-			cmd := fragmentation.Command{
-				CID: fragmentation.FragSessionSetupReq,
-				Payload: &fragmentation.FragSessionSetupReqPayload{
-					FragSession: fragmentation.FragSessionSetupReqPayloadFragSession{
-						FragIndex:      d.opts.FragmentationSessionIndex,
-						McGroupBitMask: [4]bool{d.opts.MulticastGroupID == 0, d.opts.MulticastGroupID == 1, d.opts.MulticastGroupID == 2, d.opts.MulticastGroupID == 3},
-					},
-					NbFrag:   uint16(nbFrag),
-					FragSize: uint8(d.opts.FragSize),
-					Control: fragmentation.FragSessionSetupReqPayloadControl{
-						FragmentationMatrix: d.opts.FragmentationMatrix,
-						BlockAckDelay:       d.opts.BlockAckDelay,
-					},
-					Padding:    uint8(padding),
-					Descriptor: d.opts.Descriptor,
+			pl := fragmentation.FragSessionSetupReqPayload{
+				FragSession: fragmentation.FragSessionSetupReqPayloadFragSession{
+					FragIndex:      d.fragIndex(),
+					McGroupBitMask: [4]bool{d.opts.MulticastGroupID == 0, d.opts.MulticastGroupID == 1, d.opts.MulticastGroupID == 2, d.opts.MulticastGroupID == 3},
+				},
+				NbFrag:   uint16(nbFrag),
+				FragSize: uint8(d.opts.FragSize),
+				Control: fragmentation.FragSessionSetupReqPayloadControl{
+					FragmentationMatrix: d.opts.FragmentationMatrix,
+					BlockAckDelay:       d.opts.BlockAckDelay,
 				},
+				Padding:    uint8(padding),
+				Descriptor: d.descriptor(),
+			}
+
+			cmd := fragmentation.Command{
+				CID:     fragmentation.FragSessionSetupReq,
+				Payload: &pl,
+			}
+
+			var plV2 *fragSessionSetupReqV2Payload
+			if d.fragmentationPackageVersion() == fragmentationPackageVersion2 {
+				var err error
+				plV2, err = d.fragSessionSetupReqV2Payload(ctx, devEUI, pl)
+				if err != nil {
+					return err
+				}
+				cmd.Payload = plV2
 			}
 
 			b, err := cmd.MarshalBinary()
@@ -994,6 +2547,7 @@ devLoop:
 				}).Error("fuota: enqueue payload error")
 				continue
 			}
+			d.deviceState[devEUI].setFragmentationSessionReq(true)
 
 			dl := storage.DeploymentLog{
 				DeploymentID: d.GetID(),
@@ -1002,26 +2556,30 @@ devLoop:
 				Command:      "FragSessionSetupReq",
 				Fields: hstore.Hstore{
 					Map: map[string]sql.NullString{
-						"frag_index":           sql.NullString{Valid: true, String: fmt.Sprintf("%d", d.opts.FragmentationSessionIndex)},
+						"frag_index":           sql.NullString{Valid: true, String: fmt.Sprintf("%d", d.fragIndex())},
 						"McGroupBitMask":       sql.NullString{Valid: true, String: fmt.Sprintf("%d", uint32(1<<d.opts.MulticastGroupID))},
 						"nb_frag":              sql.NullString{Valid: true, String: fmt.Sprintf("%d", nbFrag)},
 						"frag_size":            sql.NullString{Valid: true, String: fmt.Sprintf("%d", d.opts.FragSize)},
 						"fragmentation_matrix": sql.NullString{Valid: true, String: fmt.Sprintf("%d", d.opts.FragmentationMatrix)},
 						"block_ack_delay":      sql.NullString{Valid: true, String: fmt.Sprintf("%d", d.opts.BlockAckDelay)},
 						"padding":              sql.NullString{Valid: true, String: fmt.Sprintf("%d", padding)},
-						"descriptor":           sql.NullString{Valid: true, String: hex.EncodeToString(d.opts.Descriptor[:])},
+						"descriptor":           sql.NullString{Valid: true, String: hex.EncodeToString(pl.Descriptor[:])},
 					},
 				},
 			}
-			if err := storage.CreateDeploymentLog(ctx, storage.DB(), &dl); err != nil {
-				log.WithError(err).Error("fuota: create deployment log error")
+			if plV2 != nil {
+				dl.Fields.Map["session_cnt"] = sql.NullString{Valid: true, String: fmt.Sprintf("%d", plV2.SessionCnt)}
+				dl.Fields.Map["mic"] = sql.NullString{Valid: true, String: hex.EncodeToString(plV2.MIC[:])}
 			}
+			d.createDeploymentLog(ctx, EventTypeDeploymentLog, &dl)
 		}
 
 		select {
 		// sleep until next retry
 		case <-time.After(d.opts.UnicastTimeout):
 			continue devLoop
+		case <-ctx.Done():
+			return ctx.Err()
 			// terminate when all devices have been setup
 		case <-d.fragmentationSessionSetupDone:
 			log.WithField("deployment_id", d.GetID()).Info("fuota: fragmentation-session setup completed successful for all devices")
@@ -1059,8 +2617,22 @@ devLoop:
 			break
 		}
 
-		d.sessionStartTime = time.Now().Add(d.opts.UnicastTimeout)
-		d.sessionEndTime = d.sessionStartTime.Add(time.Duration(1<<d.opts.MulticastTimeout) * time.Second)
+		d.sessionStartTime = d.multicastSessionStartTime(time.Now())
+		d.sessionEndTime = d.sessionStartTime.Add(d.multicastSessionDuration())
+
+		// the retries must not move the multicast-session outside the
+		// maintenance window, in which case the session is setup again
+		// within the next maintenance window. The attempts are counted over
+		// all maintenance windows, so that the number of windows is bounded.
+		if !inMaintenanceWindow(d.opts.MaintenanceWindows, d.sessionStartTime, d.sessionEndTime) {
+			log.WithField("deployment_id", d.GetID()).Warning("fuota: multicast class-b session no longer fits maintenance window, waiting for next maintenance window")
+			if err := d.waitForNextMaintenanceWindow(ctx); err != nil {
+				return err
+			}
+
+			d.sessionStartTime = d.multicastSessionStartTime(time.Now())
+			d.sessionEndTime = d.sessionStartTime.Add(d.multicastSessionDuration())
+		}
 
 		for devEUI := range d.opts.Devices {
 			// ignore devices that have not setup the fragmentation session
@@ -1132,15 +2704,15 @@ devLoop:
 					},
 				},
 			}
-			if err := storage.CreateDeploymentLog(ctx, storage.DB(), &dl); err != nil {
-				log.WithError(err).Error("fuota: create deployment log error")
-			}
+			d.createDeploymentLog(ctx, EventTypeDeploymentLog, &dl)
 		}
 
 		select {
 		// sleep until next retry
 		case <-time.After(d.opts.UnicastTimeout):
 			continue devLoop
+		case <-ctx.Done():
+			return ctx.Err()
 		case <-d.multicastSessionSetupDone:
 			log.WithField("deployment_id", d.GetID()).Info("fuota: multicast class-b session setup completed successful for all devices")
 			break devLoop
@@ -1153,6 +2725,8 @@ devLoop:
 	}
 	now := time.Now()
 	sd.MCSessionCompletedAt = &now
+	sd.SessionStartTime = &d.sessionStartTime
+	sd.SessionEndTime = &d.sessionEndTime
 	if err := storage.UpdateDeployment(ctx, storage.DB(), &sd); err != nil {
 		return fmt.Errorf("update deployment error: %w", err)
 	}
@@ -1177,8 +2751,22 @@ devLoop:
 			break
 		}
 
-		d.sessionStartTime = time.Now().Add(d.opts.UnicastTimeout)
-		d.sessionEndTime = d.sessionStartTime.Add(time.Duration(1<<d.opts.MulticastTimeout) * time.Second)
+		d.sessionStartTime = d.multicastSessionStartTime(time.Now())
+		d.sessionEndTime = d.sessionStartTime.Add(d.multicastSessionDuration())
+
+		// the retries must not move the multicast-session outside the
+		// maintenance window, in which case the session is setup again
+		// within the next maintenance window. The attempts are counted over
+		// all maintenance windows, so that the number of windows is bounded.
+		if !inMaintenanceWindow(d.opts.MaintenanceWindows, d.sessionStartTime, d.sessionEndTime) {
+			log.WithField("deployment_id", d.GetID()).Warning("fuota: multicast class-c session no longer fits maintenance window, waiting for next maintenance window")
+			if err := d.waitForNextMaintenanceWindow(ctx); err != nil {
+				return err
+			}
+
+			d.sessionStartTime = d.multicastSessionStartTime(time.Now())
+			d.sessionEndTime = d.sessionStartTime.Add(d.multicastSessionDuration())
+		}
 
 		for devEUI := range d.opts.Devices {
 			// ignore devices that have not setup the fragmentation session
@@ -1248,15 +2836,15 @@ devLoop:
 					},
 				},
 			}
-			if err := storage.CreateDeploymentLog(ctx, storage.DB(), &dl); err != nil {
-				log.WithError(err).Error("fuota: create deployment log error")
-			}
+			d.createDeploymentLog(ctx, EventTypeDeploymentLog, &dl)
 		}
 
 		select {
 		// sleep until next retry
 		case <-time.After(d.opts.UnicastTimeout):
 			continue devLoop
+		case <-ctx.Done():
+			return ctx.Err()
 		case <-d.multicastSessionSetupDone:
 			log.WithField("deployment_id", d.GetID()).Info("fuota: multicast class-c session setup completed successful for all devices")
 			break devLoop
@@ -1269,6 +2857,8 @@ devLoop:
 	}
 	now := time.Now()
 	sd.MCSessionCompletedAt = &now
+	sd.SessionStartTime = &d.sessionStartTime
+	sd.SessionEndTime = &d.sessionEndTime
 	if err := storage.UpdateDeployment(ctx, storage.DB(), &sd); err != nil {
 		return fmt.Errorf("update deployment error: %w", err)
 	}
@@ -1285,33 +2875,23 @@ func (d *Deployment) stepEnqueue(ctx context.Context) error {
 			"deployment_id": d.GetID(),
 			"sleep_time":    timeDiff,
 		}).Info("fuota: waiting with enqueue until multicast-session starts")
-		time.Sleep(timeDiff)
+		if err := sleep(ctx, timeDiff); err != nil {
+			return err
+		}
 	}
 
 	// fragment the payload
-	padding := (d.opts.FragSize - (len(d.opts.Payload) % d.opts.FragSize)) % d.opts.FragSize
-	fragments, err := fragmentation.Encode(append(d.opts.Payload, make([]byte, padding)...), d.opts.FragSize, d.opts.Redundancy)
+	fragments, err := d.encodeFragments(d.opts.Redundancy)
 	if err != nil {
-		return fmt.Errorf("fragment payload error: %w", err)
+		return err
 	}
 
 	// wrap the payloads into data-fragment payloads
 	var payloads [][]byte
 	for i := range fragments {
-		cmd := fragmentation.Command{
-			CID: fragmentation.DataFragment,
-			Payload: &fragmentation.DataFragmentPayload{
-				IndexAndN: fragmentation.DataFragmentPayloadIndexAndN{
-					FragIndex: uint8(d.opts.FragmentationSessionIndex),
-					N:         uint16(i + 1),
-				},
-				Payload: fragments[i],
-			},
-		}
-
-		b, err := cmd.MarshalBinary()
+		b, err := d.dataFragment(i+1, fragments[i])
 		if err != nil {
-			return fmt.Errorf("marshal binary error: %w", err)
+			return err
 		}
 
 		payloads = append(payloads, b)
@@ -1319,6 +2899,10 @@ func (d *Deployment) stepEnqueue(ctx context.Context) error {
 
 	// enqueue the payloads
 	for i, b := range payloads {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
 		_, err = as.MulticastGroupClient().Enqueue(ctx, &api.EnqueueMulticastGroupQueueItemRequest{
 			QueueItem: &api.MulticastGroupQueueItem{
 				MulticastGroupId: d.multicastGroupID,
@@ -1327,6 +2911,9 @@ func (d *Deployment) stepEnqueue(ctx context.Context) error {
 				Data:             b,
 			},
 		})
+		if err != nil {
+			return fmt.Errorf("enqueue multicast-group queue item error: %w", err)
+		}
 	}
 
 	sd, err := storage.GetDeployment(ctx, storage.DB(), d.GetID())
@@ -1342,6 +2929,41 @@ func (d *Deployment) stepEnqueue(ctx context.Context) error {
 	return nil
 }
 
+// encodeFragments returns the fragments of the (padded) payload chunk,
+// followed by the given number of redundancy fragments.
+func (d *Deployment) encodeFragments(redundancy int) ([][]byte, error) {
+	payload := d.chunkPayload()
+	padding := (d.opts.FragSize - (len(payload) % d.opts.FragSize)) % d.opts.FragSize
+	fragments, err := fragmentation.Encode(append(payload, make([]byte, padding)...), d.opts.FragSize, redundancy)
+	if err != nil {
+		return nil, fmt.Errorf("fragment payload error: %w", err)
+	}
+
+	return fragments, nil
+}
+
+// dataFragment returns the DataFragment command for the given fragment
+// number (starting at 1) and fragment.
+func (d *Deployment) dataFragment(n int, fragment []byte) ([]byte, error) {
+	cmd := fragmentation.Command{
+		CID: fragmentation.DataFragment,
+		Payload: &fragmentation.DataFragmentPayload{
+			IndexAndN: fragmentation.DataFragmentPayloadIndexAndN{
+				FragIndex: d.fragIndex(),
+				N:         uint16(n),
+			},
+			Payload: fragment,
+		},
+	}
+
+	b, err := cmd.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("marshal binary error: %w", err)
+	}
+
+	return b, nil
+}
+
 func (d *Deployment) stepFragSessionStatus(ctx context.Context) error {
 	if d.opts.RequestFragmentationSessionStatus == RequestFragmentationSessionStatusNoRequest {
 		log.WithField("deployment_id", d.GetID()).Info("fuota: skipping fragmentation-session status request as requested")
@@ -1355,7 +2977,9 @@ func (d *Deployment) stepFragSessionStatus(ctx context.Context) error {
 				"deployment_id": d.GetID(),
 				"sleep_time":    timeDiff,
 			}).Info("fuota: waiting for multicast-session to end for devices before sending fragmentation-session status request")
-			time.Sleep(timeDiff)
+			if err := sleep(ctx, timeDiff); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -1387,50 +3011,12 @@ devLoop:
 				"attempt":       attempt,
 			}).Info("fuota: request fragmentation-session status for device")
 
-			cmd := fragmentation.Command{
-				CID: fragmentation.FragSessionStatusReq,
-				Payload: &fragmentation.FragSessionStatusReqPayload{
-					FragStatusReqParam: fragmentation.FragSessionStatusReqPayloadFragStatusReqParam{
-						FragIndex:    d.opts.FragmentationSessionIndex,
-						Participants: true,
-					},
-				},
-			}
-
-			b, err := cmd.MarshalBinary()
-			if err != nil {
-				return fmt.Errorf("marshal binary error: %w", err)
-			}
-
-			_, err = as.DeviceClient().Enqueue(ctx, &api.EnqueueDeviceQueueItemRequest{
-				QueueItem: &api.DeviceQueueItem{
-					DevEui: devEUI.String(),
-					FPort:  uint32(fragmentation.DefaultFPort),
-					Data:   b,
-				},
-			})
-			if err != nil {
+			if err := d.enqueueFragSessionStatusReq(ctx, devEUI); err != nil {
 				log.WithError(err).WithFields(log.Fields{
 					"deployment_id": d.GetID(),
 					"dev_eui":       devEUI,
 				}).Error("fuota: enqueue payload error")
 			}
-
-			dl := storage.DeploymentLog{
-				DeploymentID: d.GetID(),
-				DevEUI:       devEUI,
-				FPort:        uint8(fragmentation.DefaultFPort),
-				Command:      "FragSessionStatusReq",
-				Fields: hstore.Hstore{
-					Map: map[string]sql.NullString{
-						"frag_index":   sql.NullString{Valid: true, String: fmt.Sprintf("%d", d.opts.FragmentationSessionIndex)},
-						"participants": sql.NullString{Valid: true, String: "true"},
-					},
-				},
-			}
-			if err := storage.CreateDeploymentLog(ctx, storage.DB(), &dl); err != nil {
-				log.WithError(err).Error("fuota: create deployment log error")
-			}
 		}
 
 		// wait until multicast-session has ended for all devices
@@ -1441,7 +3027,9 @@ devLoop:
 					"deployment_id": d.GetID(),
 					"sleep_time":    timeDiff,
 				}).Info("fuota: waiting for multicast-session to end for devices")
-				time.Sleep(timeDiff)
+				if err := sleep(ctx, timeDiff); err != nil {
+					return err
+				}
 			}
 		}
 
@@ -1449,6 +3037,8 @@ devLoop:
 		// sleep until next retry
 		case <-time.After(d.opts.UnicastTimeout):
 			continue devLoop
+		case <-ctx.Done():
+			return ctx.Err()
 		case <-d.fragmentationSessionStatusDone:
 			log.WithField("deployment_id", d.GetID()).Info("fuota: fragmentation-session status request completed successful for all devices")
 			break devLoop
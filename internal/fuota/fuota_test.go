@@ -0,0 +1,339 @@
+package fuota
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/applayer/fragmentation"
+	"github.com/brocaar/lorawan/applayer/multicastsetup"
+	"github.com/brocaar/lorawan/gps"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/client/as"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/storage"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/test"
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+	"github.com/chirpstack/chirpstack/api/go/v4/common"
+)
+
+type FUOTATestSuite struct {
+	suite.Suite
+}
+
+func (s *FUOTATestSuite) SetupSuite() {
+	conf := test.GetConfig()
+	if err := storage.Setup(&conf); err != nil {
+		panic(err)
+	}
+}
+
+func (s *FUOTATestSuite) SetupTest() {
+	if err := storage.MigrateDown(storage.DB()); err != nil {
+		panic(err)
+	}
+	if err := storage.MigrateUp(storage.DB()); err != nil {
+		panic(err)
+	}
+}
+
+func (s *FUOTATestSuite) classBDeployment(devEUI lorawan.EUI64) *Deployment {
+	d, err := NewDeployment(DeploymentOptions{
+		ApplicationID: "app-1",
+		Devices: map[lorawan.EUI64]DeviceOptions{
+			devEUI: {},
+		},
+		MulticastGroupType:                api.MulticastGroupType_CLASS_B,
+		MulticastDR:                       3,
+		MulticastPingSlotPeriodicity:      4,
+		MulticastFrequency:                868100000,
+		MulticastGroupID:                  1,
+		MulticastTimeout:                  2,
+		MulticastRegion:                   common.Region_EU868,
+		UnicastTimeout:                    100 * time.Millisecond,
+		UnicastAttemptCount:               1,
+		FragSize:                          10,
+		Payload:                           []byte("hello world, this is the payload"),
+		Redundancy:                        2,
+		FragmentationSessionIndex:         1,
+		RequestFragmentationSessionStatus: RequestFragmentationSessionStatusNoRequest,
+	})
+	s.Require().NoError(err)
+
+	return d
+}
+
+func (s *FUOTATestSuite) TestMulticastClassBSessionSetup() {
+	assert := require.New(s.T())
+
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	mock := test.NewMockDeviceServiceClient(ctrl)
+	as.SetDeviceClient(mock)
+
+	devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+	d := s.classBDeployment(devEUI)
+	d.deviceState[devEUI].setFragmentationSessionSetup(true)
+
+	var req *api.EnqueueDeviceQueueItemRequest
+	mock.EXPECT().Enqueue(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, r *api.EnqueueDeviceQueueItemRequest, opts ...interface{}) (*api.EnqueueDeviceQueueItemResponse, error) {
+			req = r
+			return &api.EnqueueDeviceQueueItemResponse{}, nil
+		},
+	)
+
+	setupTime := time.Now()
+	assert.NoError(d.stepMulticastClassBSessionSetup(context.Background()))
+
+	assert.NotNil(req)
+	assert.Equal(devEUI.String(), req.GetQueueItem().GetDevEui())
+	assert.Equal(uint32(multicastsetup.DefaultFPort), req.GetQueueItem().GetFPort())
+
+	var cmd multicastsetup.Command
+	assert.NoError(cmd.UnmarshalBinary(false, req.GetQueueItem().GetData()))
+	assert.Equal(multicastsetup.McClassBSessionReq, cmd.CID)
+
+	sessionTime := uint32((gps.Time(d.sessionStartTime).TimeSinceGPSEpoch() / time.Second) % (1 << 32))
+	assert.Equal(&multicastsetup.McClassBSessionReqPayload{
+		McGroupIDHeader: multicastsetup.McClassBSessionReqPayloadMcGroupIDHeader{
+			McGroupID: 1,
+		},
+		SessionTime: sessionTime,
+		TimeOutPeriodicity: multicastsetup.McClassBSessionReqPayloadTimeOutPeriodicity{
+			Periodicity: 4,
+			TimeOut:     2,
+		},
+		DLFrequency: 868100000,
+		DR:          3,
+	}, cmd.Payload)
+
+	// the session starts at the first beacon after the unicast timeout
+	assert.Equal(uint32(0), sessionTime%128)
+	assert.False(d.sessionStartTime.Before(setupTime.Add(d.opts.UnicastTimeout)))
+	assert.True(d.sessionStartTime.Before(setupTime.Add(d.opts.UnicastTimeout + beaconPeriod)))
+
+	// 2^2 beacon periods
+	assert.Equal(4*beaconPeriod, d.sessionEndTime.Sub(d.sessionStartTime))
+
+	sd, err := storage.GetDeployment(context.Background(), storage.DB(), d.GetID())
+	assert.NoError(err)
+	assert.NotNil(sd.MCSessionCompletedAt)
+	assert.True(d.sessionStartTime.Equal(*sd.SessionStartTime))
+	assert.True(d.sessionEndTime.Equal(*sd.SessionEndTime))
+}
+
+func (s *FUOTATestSuite) TestEnqueue() {
+	assert := require.New(s.T())
+
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	mock := test.NewMockMulticastGroupServiceClient(ctrl)
+	as.SetMulticastGroupClient(mock)
+
+	d := s.classBDeployment(lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8})
+	d.multicastGroupID = "mc-group-1"
+	d.sessionStartTime = time.Now().Add(200 * time.Millisecond)
+
+	var enqueuedAt []time.Time
+	var items []*api.MulticastGroupQueueItem
+	mock.EXPECT().Enqueue(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, r *api.EnqueueMulticastGroupQueueItemRequest, opts ...interface{}) (*api.EnqueueMulticastGroupQueueItemResponse, error) {
+			enqueuedAt = append(enqueuedAt, time.Now())
+			items = append(items, r.GetQueueItem())
+			return &api.EnqueueMulticastGroupQueueItemResponse{}, nil
+		},
+	).Times(6)
+
+	assert.NoError(d.stepEnqueue(context.Background()))
+
+	// 32 bytes payload / 10 bytes fragments = 4 fragments + 2 redundancy
+	assert.Len(items, 6)
+	for i, item := range items {
+		assert.False(enqueuedAt[i].Before(d.sessionStartTime))
+		assert.Equal("mc-group-1", item.GetMulticastGroupId())
+		assert.Equal(uint32(i), item.GetFCnt())
+		assert.Equal(uint32(fragmentation.DefaultFPort), item.GetFPort())
+
+		var cmd fragmentation.Command
+		assert.NoError(cmd.UnmarshalBinary(false, item.GetData()))
+		assert.Equal(fragmentation.DataFragment, cmd.CID)
+
+		pl, ok := cmd.Payload.(*fragmentation.DataFragmentPayload)
+		assert.True(ok)
+		assert.Equal(uint8(1), pl.IndexAndN.FragIndex)
+		assert.Equal(uint16(i+1), pl.IndexAndN.N)
+		assert.Len(pl.Payload, 10)
+	}
+
+	sd, err := storage.GetDeployment(context.Background(), storage.DB(), d.GetID())
+	assert.NoError(err)
+	assert.NotNil(sd.EnqueueCompletedAt)
+}
+
+func (s *FUOTATestSuite) TestDeviceCleanup() {
+	assert := require.New(s.T())
+
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	mock := test.NewMockDeviceServiceClient(ctrl)
+	as.SetDeviceClient(mock)
+
+	devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+	d := s.classBDeployment(devEUI)
+	d.deviceState[devEUI].setMulticastSetup(true)
+	d.deviceState[devEUI].setFragmentationSessionSetup(true)
+
+	var cids []string
+	mock.EXPECT().Enqueue(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, r *api.EnqueueDeviceQueueItemRequest, opts ...interface{}) (*api.EnqueueDeviceQueueItemResponse, error) {
+			switch r.GetQueueItem().GetFPort() {
+			case uint32(multicastsetup.DefaultFPort):
+				var cmd multicastsetup.Command
+				assert.NoError(cmd.UnmarshalBinary(false, r.GetQueueItem().GetData()))
+				cids = append(cids, cmd.CID.String())
+
+				assert.NoError(d.handleMcGroupDeleteAns(ctx, devEUI, &multicastsetup.McGroupDeleteAnsPayload{
+					McGroupIDHeader: multicastsetup.McGroupDeleteAnsPayloadMcGroupIDHeader{
+						McGroupID: 1,
+					},
+				}))
+			case uint32(fragmentation.DefaultFPort):
+				var cmd fragmentation.Command
+				assert.NoError(cmd.UnmarshalBinary(false, r.GetQueueItem().GetData()))
+				cids = append(cids, cmd.CID.String())
+
+				assert.NoError(d.handleFragSessionDeleteAns(ctx, devEUI, &fragmentation.FragSessionDeleteAnsPayload{
+					Status: fragmentation.FragSessionDeleteAnsPayloadStatus{
+						FragIndex:           1,
+						SessionDoesNotExist: true,
+					},
+				}))
+			}
+			return &api.EnqueueDeviceQueueItemResponse{}, nil
+		},
+	).Times(2)
+
+	assert.NoError(d.stepDeviceCleanup(context.Background()))
+	assert.ElementsMatch([]string{"FragSessionDeleteReq", "McGroupDeleteReq"}, cids)
+	assert.True(d.deviceState[devEUI].getMulticastDelete())
+	assert.True(d.deviceState[devEUI].getFragmentationSessionDelete())
+
+	sd, err := storage.GetDeployment(context.Background(), storage.DB(), d.GetID())
+	assert.NoError(err)
+	assert.NotNil(sd.DeviceCleanupCompletedAt)
+}
+
+func (s *FUOTATestSuite) TestCleanupCancelled() {
+	assert := require.New(s.T())
+
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	mock := test.NewMockDeviceServiceClient(ctrl)
+	as.SetDeviceClient(mock)
+
+	devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+	d := s.classBDeployment(devEUI)
+	d.opts.UnicastAttemptCount = 2
+
+	// the device did not (yet) answer the setup requests
+	d.deviceState[devEUI].setMulticastSetupReq(true)
+	d.deviceState[devEUI].setFragmentationSessionReq(true)
+
+	// the delete requests are answered on the second attempt
+	var cids []string
+	mock.EXPECT().Enqueue(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, r *api.EnqueueDeviceQueueItemRequest, opts ...interface{}) (*api.EnqueueDeviceQueueItemResponse, error) {
+			answer := len(cids) >= 2
+
+			switch r.GetQueueItem().GetFPort() {
+			case uint32(multicastsetup.DefaultFPort):
+				var cmd multicastsetup.Command
+				assert.NoError(cmd.UnmarshalBinary(false, r.GetQueueItem().GetData()))
+				cids = append(cids, cmd.CID.String())
+
+				if answer {
+					assert.NoError(d.handleMcGroupDeleteAns(ctx, devEUI, &multicastsetup.McGroupDeleteAnsPayload{
+						McGroupIDHeader: multicastsetup.McGroupDeleteAnsPayloadMcGroupIDHeader{
+							McGroupID: 1,
+						},
+					}))
+				}
+			case uint32(fragmentation.DefaultFPort):
+				var cmd fragmentation.Command
+				assert.NoError(cmd.UnmarshalBinary(false, r.GetQueueItem().GetData()))
+				cids = append(cids, cmd.CID.String())
+
+				if answer {
+					assert.NoError(d.handleFragSessionDeleteAns(ctx, devEUI, &fragmentation.FragSessionDeleteAnsPayload{
+						Status: fragmentation.FragSessionDeleteAnsPayloadStatus{
+							FragIndex: 1,
+						},
+					}))
+				}
+			}
+			return &api.EnqueueDeviceQueueItemResponse{}, nil
+		},
+	).Times(4)
+
+	assert.NoError(d.cleanupCancelled(context.Background()))
+	assert.ElementsMatch([]string{"FragSessionDeleteReq", "McGroupDeleteReq", "FragSessionDeleteReq", "McGroupDeleteReq"}, cids)
+	assert.True(d.deviceState[devEUI].getMulticastDelete())
+	assert.True(d.deviceState[devEUI].getFragmentationSessionDelete())
+
+	sd, err := storage.GetDeployment(context.Background(), storage.DB(), d.GetID())
+	assert.NoError(err)
+	assert.Equal(storage.DeploymentStateCancelled, sd.State)
+	assert.NotNil(sd.CancelledAt)
+}
+
+func TestFUOTA(t *testing.T) {
+	suite.Run(t, new(FUOTATestSuite))
+}
+
+func TestMulticastSessionTiming(t *testing.T) {
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Class-B", func(t *testing.T) {
+		assert := require.New(t)
+
+		d := newDeployment(uuid.Nil, DeploymentOptions{
+			MulticastGroupType: api.MulticastGroupType_CLASS_B,
+			MulticastTimeout:   3,
+			UnicastTimeout:     time.Minute,
+		})
+
+		start := d.multicastSessionStartTime(now)
+		sinceEpoch := gps.Time(start).TimeSinceGPSEpoch()
+		assert.Equal(time.Duration(0), sinceEpoch%beaconPeriod)
+		assert.False(start.Before(now.Add(time.Minute)))
+		assert.True(start.Before(now.Add(time.Minute + beaconPeriod)))
+
+		// already aligned
+		aligned := time.Time(gps.NewTimeFromTimeSinceGPSEpoch(sinceEpoch))
+		assert.True(aligned.Equal(d.multicastSessionStartTime(aligned.Add(-time.Minute))))
+
+		assert.Equal(8*beaconPeriod, d.multicastSessionDuration())
+	})
+
+	t.Run("Class-C", func(t *testing.T) {
+		assert := require.New(t)
+
+		d := newDeployment(uuid.Nil, DeploymentOptions{
+			MulticastGroupType: api.MulticastGroupType_CLASS_C,
+			MulticastTimeout:   3,
+			UnicastTimeout:     time.Minute,
+		})
+
+		assert.True(now.Add(time.Minute).Equal(d.multicastSessionStartTime(now)))
+		assert.Equal(8*time.Second, d.multicastSessionDuration())
+	})
+}
@@ -0,0 +1,119 @@
+package fuota
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+)
+
+// MaintenanceWindow defines a time window in which the multicast-session is
+// allowed to take place.
+type MaintenanceWindow struct {
+	Start time.Time
+	End   time.Time
+}
+
+// nextSessionSetupTime returns the earliest time at or after now at which the
+// multicast-session setup can be started, such that the multicast-session
+// (starting after the setup duration) ends within the same maintenance
+// window. It returns false when none of the windows fits the session.
+func nextSessionSetupTime(windows []MaintenanceWindow, now time.Time, setup, session time.Duration) (time.Time, bool) {
+	var next time.Time
+	var found bool
+
+	for _, w := range windows {
+		start := w.Start
+		if now.After(start) {
+			start = now
+		}
+
+		if start.Add(setup + session).After(w.End) {
+			continue
+		}
+
+		if !found || start.Before(next) {
+			next = start
+			found = true
+		}
+	}
+
+	return next, found
+}
+
+// inMaintenanceWindow returns true when the given period falls within one of
+// the maintenance windows or when no maintenance windows are defined.
+func inMaintenanceWindow(windows []MaintenanceWindow, start, end time.Time) bool {
+	if len(windows) == 0 {
+		return true
+	}
+
+	for _, w := range windows {
+		if !start.Before(w.Start) && !end.After(w.End) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Wait until the multicast-session fits within one of the maintenance
+// windows. This step does nothing when no maintenance windows are defined.
+func (d *Deployment) stepWaitForMaintenanceWindow(ctx context.Context) error {
+	if len(d.opts.MaintenanceWindows) == 0 {
+		return nil
+	}
+
+	// a Class-B session start is delayed until the next beacon period
+	setup := d.opts.UnicastTimeout
+	if d.opts.MulticastGroupType == api.MulticastGroupType_CLASS_B {
+		setup += beaconPeriod
+	}
+
+	setupTime, ok := nextSessionSetupTime(d.opts.MaintenanceWindows, time.Now(), setup, d.multicastSessionDuration())
+	if !ok {
+		return errors.New("no maintenance window left that fits the multicast-session")
+	}
+
+	timeDiff := time.Until(setupTime)
+	if timeDiff > 0 {
+		log.WithFields(log.Fields{
+			"deployment_id": d.GetID(),
+			"sleep_time":    timeDiff,
+		}).Info("fuota: waiting for maintenance window before multicast-session setup")
+		if err := sleep(ctx, timeDiff); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// waitForNextMaintenanceWindow waits until the multicast-session fits within
+// the next maintenance window. As the devices must be setup again using the
+// new session time, their multicast-session setup state is reset.
+func (d *Deployment) waitForNextMaintenanceWindow(ctx context.Context) error {
+	if err := d.stepWaitForMaintenanceWindow(ctx); err != nil {
+		return err
+	}
+
+	start := d.multicastSessionStartTime(time.Now())
+	if !inMaintenanceWindow(d.opts.MaintenanceWindows, start, start.Add(d.multicastSessionDuration())) {
+		return errors.New("multicast-session does not fit the maintenance window")
+	}
+
+	for _, state := range d.deviceState {
+		state.setMulicastSessionSetup(false)
+	}
+
+	// discard the done signal of the previous multicast-session setup
+	select {
+	case <-d.multicastSessionSetupDone:
+	default:
+	}
+
+	return nil
+}
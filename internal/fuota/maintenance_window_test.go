@@ -0,0 +1,193 @@
+package fuota
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/applayer/multicastsetup"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/client/as"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/test"
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+)
+
+func TestNextSessionSetupTime(t *testing.T) {
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		windows  []MaintenanceWindow
+		expected time.Time
+		found    bool
+	}{
+		{
+			name: "no windows",
+		},
+		{
+			name: "now within window",
+			windows: []MaintenanceWindow{
+				{Start: now.Add(-time.Hour), End: now.Add(time.Hour)},
+			},
+			expected: now,
+			found:    true,
+		},
+		{
+			name: "future window",
+			windows: []MaintenanceWindow{
+				{Start: now.Add(2 * time.Hour), End: now.Add(3 * time.Hour)},
+			},
+			expected: now.Add(2 * time.Hour),
+			found:    true,
+		},
+		{
+			name: "remaining window too short",
+			windows: []MaintenanceWindow{
+				{Start: now.Add(-time.Hour), End: now.Add(10 * time.Minute)},
+				{Start: now.Add(5 * time.Hour), End: now.Add(6 * time.Hour)},
+			},
+			expected: now.Add(5 * time.Hour),
+			found:    true,
+		},
+		{
+			name: "earliest window",
+			windows: []MaintenanceWindow{
+				{Start: now.Add(5 * time.Hour), End: now.Add(6 * time.Hour)},
+				{Start: now.Add(2 * time.Hour), End: now.Add(3 * time.Hour)},
+			},
+			expected: now.Add(2 * time.Hour),
+			found:    true,
+		},
+		{
+			name: "expired window",
+			windows: []MaintenanceWindow{
+				{Start: now.Add(-2 * time.Hour), End: now.Add(-time.Hour)},
+			},
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.name, func(t *testing.T) {
+			assert := require.New(t)
+
+			next, found := nextSessionSetupTime(tst.windows, now, 5*time.Minute, 10*time.Minute)
+			assert.Equal(tst.found, found)
+			assert.True(tst.expected.Equal(next))
+		})
+	}
+}
+
+func TestInMaintenanceWindow(t *testing.T) {
+	assert := require.New(t)
+
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	windows := []MaintenanceWindow{
+		{Start: now, End: now.Add(time.Hour)},
+	}
+
+	assert.True(inMaintenanceWindow(nil, now, now.Add(2*time.Hour)))
+	assert.True(inMaintenanceWindow(windows, now, now.Add(time.Hour)))
+	assert.False(inMaintenanceWindow(windows, now, now.Add(2*time.Hour)))
+	assert.False(inMaintenanceWindow(windows, now.Add(-time.Minute), now.Add(time.Minute)))
+}
+
+func (s *FUOTATestSuite) TestMulticastSessionSetupMaintenanceWindow() {
+	devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+
+	newDeployment := func(windows []MaintenanceWindow) *Deployment {
+		d, err := NewDeployment(DeploymentOptions{
+			ApplicationID: "app-1",
+			Devices: map[lorawan.EUI64]DeviceOptions{
+				devEUI: {},
+			},
+			MulticastGroupType:  api.MulticastGroupType_CLASS_C,
+			MulticastGroupID:    1,
+			MulticastTimeout:    0,
+			UnicastTimeout:      100 * time.Millisecond,
+			UnicastAttemptCount: 1,
+			FragSize:            10,
+			Payload:             []byte("hello world"),
+			MaintenanceWindows:  windows,
+		})
+		s.Require().NoError(err)
+		d.deviceState[devEUI].setFragmentationSessionSetup(true)
+		d.deviceState[devEUI].setMulicastSessionSetup(true)
+		return d
+	}
+
+	s.T().Run("Wait for next maintenance window", func(t *testing.T) {
+		assert := require.New(t)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mock := test.NewMockDeviceServiceClient(ctrl)
+		as.SetDeviceClient(mock)
+
+		// the current window ends before the multicast-session would end
+		now := time.Now()
+		next := MaintenanceWindow{Start: now.Add(300 * time.Millisecond), End: now.Add(time.Hour)}
+		d := newDeployment([]MaintenanceWindow{
+			{Start: now.Add(-time.Hour), End: now.Add(500 * time.Millisecond)},
+			next,
+		})
+
+		var enqueuedAt time.Time
+		mock.EXPECT().Enqueue(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, r *api.EnqueueDeviceQueueItemRequest, opts ...interface{}) (*api.EnqueueDeviceQueueItemResponse, error) {
+				var cmd multicastsetup.Command
+				assert.NoError(cmd.UnmarshalBinary(false, r.GetQueueItem().GetData()))
+				assert.Equal(multicastsetup.McClassCSessionReq, cmd.CID)
+				enqueuedAt = time.Now()
+				return &api.EnqueueDeviceQueueItemResponse{}, nil
+			},
+		)
+
+		assert.NoError(d.stepMulticastClassCSessionSetup(context.Background()))
+		assert.False(enqueuedAt.Before(next.Start))
+		assert.True(inMaintenanceWindow([]MaintenanceWindow{next}, d.sessionStartTime, d.sessionEndTime))
+
+		// the device must be setup again using the new session time
+		assert.False(d.deviceState[devEUI].getMulticastSessionSetup())
+	})
+
+	s.T().Run("Attempts are bounded over maintenance windows", func(t *testing.T) {
+		assert := require.New(t)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mock := test.NewMockDeviceServiceClient(ctrl)
+		as.SetDeviceClient(mock)
+
+		// each window only fits the first attempt, the device never answers
+		now := time.Now()
+		var windows []MaintenanceWindow
+		for i := 0; i < 10; i++ {
+			start := now.Add(time.Duration(i) * 1300 * time.Millisecond)
+			windows = append(windows, MaintenanceWindow{Start: start, End: start.Add(1150 * time.Millisecond)})
+		}
+		d := newDeployment(windows)
+		d.opts.UnicastAttemptCount = 2
+		d.deviceState[devEUI].setMulicastSessionSetup(false)
+
+		mock.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(&api.EnqueueDeviceQueueItemResponse{}, nil).Times(2)
+
+		assert.NoError(d.stepMulticastClassCSessionSetup(context.Background()))
+		assert.True(inMaintenanceWindow(windows[1:2], d.sessionStartTime, d.sessionEndTime))
+	})
+
+	s.T().Run("No maintenance window left", func(t *testing.T) {
+		assert := require.New(t)
+
+		now := time.Now()
+		d := newDeployment([]MaintenanceWindow{
+			{Start: now.Add(-time.Hour), End: now.Add(500 * time.Millisecond)},
+		})
+
+		assert.Error(d.stepMulticastClassCSessionSetup(context.Background()))
+	})
+}
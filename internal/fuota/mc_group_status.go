@@ -0,0 +1,247 @@
+package fuota
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq/hstore"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/applayer/multicastsetup"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/client/as"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/storage"
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+)
+
+// ErrNoFreeMulticastGroupID is returned when all multicast group IDs are
+// active on at least one of the devices.
+var ErrNoFreeMulticastGroupID = errors.New("no free multicast group id available")
+
+// maxMulticastGroups defines the number of multicast-groups that can be
+// addressed by the McGroupID field (2 bits).
+const maxMulticastGroups = 4
+
+func (d *Deployment) handleMcGroupStatusAns(ctx context.Context, devEUI lorawan.EUI64, pl *multicastsetup.McGroupStatusAnsPayload) error {
+	log.WithFields(log.Fields{
+		"deployment_id":   d.GetID(),
+		"dev_eui":         devEUI,
+		"nb_total_groups": pl.Status.NbTotalGroups,
+		"ans_group_mask":  pl.Status.AnsGroupMask,
+	}).Info("fuota: McGroupStatusAns received")
+
+	dl := storage.DeploymentLog{
+		DeploymentID: d.GetID(),
+		DevEUI:       devEUI,
+		FPort:        multicastsetup.DefaultFPort,
+		Command:      "McGroupStatusAns",
+		Fields: hstore.Hstore{
+			Map: map[string]sql.NullString{
+				"nb_total_groups": sql.NullString{Valid: true, String: fmt.Sprintf("%d", pl.Status.NbTotalGroups)},
+			},
+		},
+	}
+	for _, item := range pl.Items {
+		dl.Fields.Map[fmt.Sprintf("mc_group_%d_mc_addr", item.McGroupID)] = sql.NullString{Valid: true, String: item.McAddr.String()}
+	}
+	d.createDeploymentLog(ctx, EventTypeDeviceAnswer, &dl)
+
+	state, ok := d.deviceState[devEUI]
+	if !d.opts.AutoMulticastGroupID || !ok {
+		return nil
+	}
+
+	if err := storage.Transaction(func(tx sqlx.Ext) error {
+		if err := storage.DeleteDeploymentDeviceMCGroups(ctx, tx, d.GetID(), devEUI); err != nil {
+			return fmt.Errorf("delete deployment device multicast-groups error: %w", err)
+		}
+
+		for _, item := range pl.Items {
+			g := storage.DeploymentDeviceMCGroup{
+				DeploymentID: d.GetID(),
+				DevEUI:       devEUI,
+				MCGroupID:    item.McGroupID,
+				MCAddr:       item.McAddr,
+			}
+			if err := storage.CreateDeploymentDeviceMCGroup(ctx, tx, &g); err != nil {
+				return fmt.Errorf("create deployment device multicast-group error: %w", err)
+			}
+		}
+
+		dd, err := storage.GetDeploymentDevice(ctx, tx, d.GetID(), devEUI)
+		if err != nil {
+			return fmt.Errorf("get deployment device error: %w", err)
+		}
+		now := time.Now()
+		dd.MCGroupStatusCompletedAt = &now
+		if err := storage.UpdateDeploymentDevice(ctx, tx, &dd); err != nil {
+			return fmt.Errorf("update deployment device error: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	state.setMulticastGroupStatus(true)
+
+	// if all devices have reported their multicast-group status, publish to
+	// done chan.
+	for _, state := range d.deviceState {
+		if !state.getExcluded() && !state.getMulticastGroupStatus() {
+			return nil
+		}
+	}
+
+	// the channel is buffered, do not block when an answer is received after
+	// the multicast-group status step has completed
+	select {
+	case d.multicastGroupStatusDone <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// Request the multicast-group status of each device and select the lowest
+// multicast group ID that is not active on any of the devices.
+func (d *Deployment) stepMulticastGroupStatus(ctx context.Context) error {
+	log.WithField("deployment_id", d.GetID()).Info("fuota: requesting multicast-group status from devices")
+
+	attempt := 0
+
+devLoop:
+	for {
+		attempt += 1
+		if attempt > d.opts.UnicastAttemptCount {
+			log.WithField("deployment_id", d.GetID()).Warning("fuota: multicast-group status reached max. number of attempts, some devices did not complete")
+			break
+		}
+
+		for devEUI := range d.opts.Devices {
+			state := d.deviceState[devEUI]
+			if state.getExcluded() || state.getMulticastGroupStatus() {
+				continue
+			}
+
+			log.WithFields(log.Fields{
+				"deployment_id": d.GetID(),
+				"dev_eui":       devEUI,
+				"attempt":       attempt,
+			}).Info("fuota: request multicast-group status from device")
+
+			if err := d.enqueueMcGroupStatusReq(ctx, devEUI); err != nil {
+				return err
+			}
+		}
+
+		select {
+		// sleep until next retry
+		case <-time.After(d.opts.UnicastTimeout):
+			continue devLoop
+		case <-ctx.Done():
+			return ctx.Err()
+		// terminate when all devices have reported their multicast-group status
+		case <-d.multicastGroupStatusDone:
+			log.WithField("deployment_id", d.GetID()).Info("fuota: multicast-group status received for all devices")
+			break devLoop
+		}
+	}
+
+	inUse, err := storage.GetDeploymentMCGroupIDsInUse(ctx, storage.DB(), d.GetID())
+	if err != nil {
+		return fmt.Errorf("get multicast-group ids in use error: %w", err)
+	}
+
+	mcGroupID, ok := freeMulticastGroupID(inUse)
+	if !ok {
+		return ErrNoFreeMulticastGroupID
+	}
+
+	log.WithFields(log.Fields{
+		"deployment_id": d.GetID(),
+		"mc_group_id":   mcGroupID,
+	}).Info("fuota: multicast group id selected")
+
+	d.opts.MulticastGroupID = mcGroupID
+
+	sd, err := storage.GetDeployment(ctx, storage.DB(), d.GetID())
+	if err != nil {
+		return fmt.Errorf("get deployment error: %w", err)
+	}
+	now := time.Now()
+	sd.MCGroupID = mcGroupID
+	sd.MCGroupStatusCompletedAt = &now
+	if err := storage.UpdateDeployment(ctx, storage.DB(), &sd); err != nil {
+		return fmt.Errorf("update deployment error: %w", err)
+	}
+
+	return nil
+}
+
+// freeMulticastGroupID returns the lowest multicast group ID that is not in
+// the given list of IDs.
+func freeMulticastGroupID(inUse []uint8) (uint8, bool) {
+	var used [maxMulticastGroups]bool
+	for _, id := range inUse {
+		if int(id) < maxMulticastGroups {
+			used[id] = true
+		}
+	}
+
+	for id := range used {
+		if !used[id] {
+			return uint8(id), true
+		}
+	}
+
+	return 0, false
+}
+
+// enqueueMcGroupStatusReq enqueues a McGroupStatusReq, requesting the status
+// of all multicast-groups, to the given device.
+func (d *Deployment) enqueueMcGroupStatusReq(ctx context.Context, devEUI lorawan.EUI64) error {
+	cmd := multicastsetup.Command{
+		CID: multicastsetup.McGroupStatusReq,
+		Payload: &multicastsetup.McGroupStatusReqPayload{
+			CmdMask: multicastsetup.McGroupStatusReqPayloadCmdMask{
+				RegGroupMask: [maxMulticastGroups]bool{true, true, true, true},
+			},
+		},
+	}
+
+	b, err := cmd.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("marshal binary error: %w", err)
+	}
+
+	_, err = as.DeviceClient().Enqueue(ctx, &api.EnqueueDeviceQueueItemRequest{
+		QueueItem: &api.DeviceQueueItem{
+			DevEui: devEUI.String(),
+			FPort:  uint32(multicastsetup.DefaultFPort),
+			Data:   b,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("enqueue payload error: %w", err)
+	}
+
+	dl := storage.DeploymentLog{
+		DeploymentID: d.GetID(),
+		DevEUI:       devEUI,
+		FPort:        multicastsetup.DefaultFPort,
+		Command:      "McGroupStatusReq",
+		Fields: hstore.Hstore{
+			Map: map[string]sql.NullString{
+				"reg_group_mask": sql.NullString{Valid: true, String: "1111"},
+			},
+		},
+	}
+	d.createDeploymentLog(ctx, EventTypeDeploymentLog, &dl)
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package fuota
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/applayer/multicastsetup"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/client/as"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/storage"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/test"
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+)
+
+func (s *FUOTATestSuite) TestMulticastGroupStatus() {
+	assert := require.New(s.T())
+
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	mock := test.NewMockDeviceServiceClient(ctrl)
+	as.SetDeviceClient(mock)
+
+	devEUI1 := lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}
+	devEUI2 := lorawan.EUI64{2, 2, 2, 2, 2, 2, 2, 2}
+
+	d, err := NewDeployment(DeploymentOptions{
+		ApplicationID: "app-1",
+		Devices: map[lorawan.EUI64]DeviceOptions{
+			devEUI1: {},
+			devEUI2: {},
+		},
+		MulticastGroupType:   api.MulticastGroupType_CLASS_C,
+		MulticastGroupID:     0,
+		AutoMulticastGroupID: true,
+		UnicastTimeout:       time.Second,
+		UnicastAttemptCount:  1,
+	})
+	assert.NoError(err)
+
+	answers := map[lorawan.EUI64]multicastsetup.McGroupStatusAnsPayload{
+		devEUI1: {
+			Status: multicastsetup.McGroupStatusAnsPayloadStatus{
+				NbTotalGroups: 1,
+				AnsGroupMask:  [4]bool{true},
+			},
+			Items: []multicastsetup.McGroupStatusAnsPayloadItem{
+				{McGroupID: 0, McAddr: lorawan.DevAddr{1, 2, 3, 4}},
+			},
+		},
+		devEUI2: {
+			Status: multicastsetup.McGroupStatusAnsPayloadStatus{
+				NbTotalGroups: 1,
+				AnsGroupMask:  [4]bool{false, true},
+			},
+			Items: []multicastsetup.McGroupStatusAnsPayloadItem{
+				{McGroupID: 1, McAddr: lorawan.DevAddr{5, 6, 7, 8}},
+			},
+		},
+	}
+
+	mock.EXPECT().Enqueue(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, r *api.EnqueueDeviceQueueItemRequest, opts ...interface{}) (*api.EnqueueDeviceQueueItemResponse, error) {
+			var cmd multicastsetup.Command
+			assert.NoError(cmd.UnmarshalBinary(false, r.GetQueueItem().GetData()))
+			assert.Equal(multicastsetup.McGroupStatusReq, cmd.CID)
+			assert.Equal(&multicastsetup.McGroupStatusReqPayload{
+				CmdMask: multicastsetup.McGroupStatusReqPayloadCmdMask{
+					RegGroupMask: [4]bool{true, true, true, true},
+				},
+			}, cmd.Payload)
+
+			var devEUI lorawan.EUI64
+			assert.NoError(devEUI.UnmarshalText([]byte(r.GetQueueItem().GetDevEui())))
+
+			pl := answers[devEUI]
+			assert.NoError(d.handleMcGroupStatusAns(ctx, devEUI, &pl))
+
+			return &api.EnqueueDeviceQueueItemResponse{}, nil
+		},
+	).Times(2)
+
+	assert.NoError(d.stepMulticastGroupStatus(context.Background()))
+	assert.Equal(uint8(2), d.opts.MulticastGroupID)
+
+	sd, err := storage.GetDeployment(context.Background(), storage.DB(), d.GetID())
+	assert.NoError(err)
+	assert.NotNil(sd.MCGroupStatusCompletedAt)
+	assert.Equal(uint8(2), sd.MCGroupID)
+
+	groups, err := storage.GetDeploymentDeviceMCGroups(context.Background(), storage.DB(), d.GetID(), devEUI2)
+	assert.NoError(err)
+	assert.Len(groups, 1)
+	assert.Equal(uint8(1), groups[0].MCGroupID)
+	assert.Equal(lorawan.DevAddr{5, 6, 7, 8}, groups[0].MCAddr)
+
+	dd, err := storage.GetDeploymentDevice(context.Background(), storage.DB(), d.GetID(), devEUI1)
+	assert.NoError(err)
+	assert.NotNil(dd.MCGroupStatusCompletedAt)
+}
+
+func TestFreeMulticastGroupID(t *testing.T) {
+	tests := []struct {
+		name      string
+		inUse     []uint8
+		id        uint8
+		available bool
+	}{
+		{"none in use", nil, 0, true},
+		{"first in use", []uint8{0}, 1, true},
+		{"gap", []uint8{0, 1, 3}, 2, true},
+		{"all in use", []uint8{3, 2, 1, 0}, 0, false},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.name, func(t *testing.T) {
+			assert := require.New(t)
+
+			id, ok := freeMulticastGroupID(tst.inUse)
+			assert.Equal(tst.available, ok)
+			assert.Equal(tst.id, id)
+		})
+	}
+}
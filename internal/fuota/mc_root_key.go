@@ -0,0 +1,134 @@
+package fuota
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/applayer/multicastsetup"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/client/as"
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+	"github.com/chirpstack/chirpstack/api/go/v4/common"
+)
+
+// DeriveMcRootKeys returns the DeviceOptions for the given devices, with the
+// McRootKey derived from the device keys stored in ChirpStack for the given
+// Remote Multicast Setup package version.
+func DeriveMcRootKeys(ctx context.Context, devEUIs []lorawan.EUI64, packageVersion uint8) (map[lorawan.EUI64]DeviceOptions, error) {
+	devices := make(map[lorawan.EUI64]DeviceOptions)
+	deriver := newMcRootKeyDeriver(packageVersion)
+
+	for _, devEUI := range devEUIs {
+		mcRootKey, err := deriver.mcRootKey(ctx, devEUI, "")
+		if err != nil {
+			return nil, err
+		}
+
+		devices[devEUI] = DeviceOptions{
+			McRootKey: mcRootKey,
+		}
+	}
+
+	return devices, nil
+}
+
+// mcRootKeyDeriver derives the McRootKey of devices. It caches the MAC
+// version per device-profile so that each device-profile is fetched only
+// once.
+type mcRootKeyDeriver struct {
+	packageVersion uint8
+	macVersions    map[string]common.MacVersion
+}
+
+func newMcRootKeyDeriver(packageVersion uint8) *mcRootKeyDeriver {
+	return &mcRootKeyDeriver{
+		packageVersion: packageVersion,
+		macVersions:    make(map[string]common.MacVersion),
+	}
+}
+
+// mcRootKey returns the McRootKey for the given device. Using the v1 package,
+// this is derived from the GenAppKey for LoRaWAN 1.0.x devices. The v2
+// package no longer uses the GenAppKey, the McRootKey is derived from the
+// LoRaWAN 1.0.x AppKey, which ChirpStack stores as the NwkKey. For LoRaWAN
+// 1.1.x devices, this is derived from the AppKey for both packages. When the
+// device-profile ID is not given, it is fetched from ChirpStack.
+func (m *mcRootKeyDeriver) mcRootKey(ctx context.Context, devEUI lorawan.EUI64, deviceProfileID string) (lorawan.AES128Key, error) {
+	var mcRootKey lorawan.AES128Key
+
+	macVersion, err := m.macVersion(ctx, devEUI, deviceProfileID)
+	if err != nil {
+		return mcRootKey, err
+	}
+
+	resp, err := as.DeviceClient().GetKeys(ctx, &api.GetDeviceKeysRequest{
+		DevEui: devEUI.String(),
+	})
+	if err != nil {
+		return mcRootKey, fmt.Errorf("get device keys error (dev_eui: %s): %w", devEUI, err)
+	}
+
+	switch {
+	case macVersion == common.MacVersion_LORAWAN_1_1_0:
+		var appKey lorawan.AES128Key
+		if err := appKey.UnmarshalText([]byte(resp.GetDeviceKeys().GetAppKey())); err != nil {
+			return mcRootKey, fmt.Errorf("unmarshal app_key error (dev_eui: %s): %w", devEUI, err)
+		}
+
+		mcRootKey, err = multicastsetup.GetMcRootKeyForAppKey(appKey)
+		if err != nil {
+			return mcRootKey, fmt.Errorf("get McRootKey for AppKey error: %w", err)
+		}
+	case m.packageVersion == multicastSetupPackageVersion2:
+		// for LoRaWAN 1.0.x devices, the AppKey is stored as nwk_key
+		var appKey lorawan.AES128Key
+		if err := appKey.UnmarshalText([]byte(resp.GetDeviceKeys().GetNwkKey())); err != nil {
+			return mcRootKey, fmt.Errorf("unmarshal nwk_key error (dev_eui: %s): %w", devEUI, err)
+		}
+
+		mcRootKey, err = multicastsetup.GetMcRootKeyForAppKey(appKey)
+		if err != nil {
+			return mcRootKey, fmt.Errorf("get McRootKey for AppKey error: %w", err)
+		}
+	default:
+		var genAppKey lorawan.AES128Key
+		if err := genAppKey.UnmarshalText([]byte(resp.GetDeviceKeys().GetGenAppKey())); err != nil {
+			return mcRootKey, fmt.Errorf("unmarshal gen_app_key error (dev_eui: %s): %w", devEUI, err)
+		}
+
+		mcRootKey, err = multicastsetup.GetMcRootKeyForGenAppKey(genAppKey)
+		if err != nil {
+			return mcRootKey, fmt.Errorf("get McRootKey for GenAppKey error: %w", err)
+		}
+	}
+
+	return mcRootKey, nil
+}
+
+// macVersion returns the LoRaWAN MAC version of the given device.
+func (m *mcRootKeyDeriver) macVersion(ctx context.Context, devEUI lorawan.EUI64, deviceProfileID string) (common.MacVersion, error) {
+	if deviceProfileID == "" {
+		resp, err := as.DeviceClient().Get(ctx, &api.GetDeviceRequest{
+			DevEui: devEUI.String(),
+		})
+		if err != nil {
+			return 0, fmt.Errorf("get device error (dev_eui: %s): %w", devEUI, err)
+		}
+		deviceProfileID = resp.GetDevice().GetDeviceProfileId()
+	}
+
+	if v, ok := m.macVersions[deviceProfileID]; ok {
+		return v, nil
+	}
+
+	resp, err := as.DeviceProfileClient().Get(ctx, &api.GetDeviceProfileRequest{
+		Id: deviceProfileID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("get device-profile error (id: %s): %w", deviceProfileID, err)
+	}
+
+	m.macVersions[deviceProfileID] = resp.GetDeviceProfile().GetMacVersion()
+
+	return m.macVersions[deviceProfileID], nil
+}
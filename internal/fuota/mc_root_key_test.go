@@ -0,0 +1,88 @@
+package fuota
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/applayer/multicastsetup"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/client/as"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/test"
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+	"github.com/chirpstack/chirpstack/api/go/v4/common"
+)
+
+func TestDeriveMcRootKeys(t *testing.T) {
+	genAppKey := lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8}
+	appKey := lorawan.AES128Key{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1}
+	nwkKey := lorawan.AES128Key{1, 1, 2, 2, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8}
+
+	genAppKeyMcRootKey, err := multicastsetup.GetMcRootKeyForGenAppKey(genAppKey)
+	require.NoError(t, err)
+	appKeyMcRootKey, err := multicastsetup.GetMcRootKeyForAppKey(appKey)
+	require.NoError(t, err)
+	nwkKeyMcRootKey, err := multicastsetup.GetMcRootKeyForAppKey(nwkKey)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		packageVersion uint8
+		macVersion     common.MacVersion
+		expected       lorawan.AES128Key
+	}{
+		{"LoRaWAN 1.0.3", 1, common.MacVersion_LORAWAN_1_0_3, genAppKeyMcRootKey},
+		{"LoRaWAN 1.1.0", 1, common.MacVersion_LORAWAN_1_1_0, appKeyMcRootKey},
+		{"LoRaWAN 1.0.3 v2 package", 2, common.MacVersion_LORAWAN_1_0_3, nwkKeyMcRootKey},
+		{"LoRaWAN 1.1.0 v2 package", 2, common.MacVersion_LORAWAN_1_1_0, appKeyMcRootKey},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.name, func(t *testing.T) {
+			assert := require.New(t)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock := test.NewMockDeviceServiceClient(ctrl)
+			as.SetDeviceClient(mock)
+			dpMock := test.NewMockDeviceProfileServiceClient(ctrl)
+			as.SetDeviceProfileClient(dpMock)
+
+			devEUIs := []lorawan.EUI64{{1, 2, 3, 4, 5, 6, 7, 8}, {8, 7, 6, 5, 4, 3, 2, 1}}
+
+			for _, devEUI := range devEUIs {
+				mock.EXPECT().Get(gomock.Any(), gomock.Eq(&api.GetDeviceRequest{
+					DevEui: devEUI.String(),
+				})).Return(&api.GetDeviceResponse{
+					Device: &api.Device{DevEui: devEUI.String(), DeviceProfileId: "dp-1"},
+				}, nil)
+				mock.EXPECT().GetKeys(gomock.Any(), gomock.Eq(&api.GetDeviceKeysRequest{
+					DevEui: devEUI.String(),
+				})).Return(&api.GetDeviceKeysResponse{
+					DeviceKeys: &api.DeviceKeys{
+						DevEui:    devEUI.String(),
+						NwkKey:    nwkKey.String(),
+						AppKey:    appKey.String(),
+						GenAppKey: genAppKey.String(),
+					},
+				}, nil)
+			}
+
+			dpMock.EXPECT().Get(gomock.Any(), gomock.Eq(&api.GetDeviceProfileRequest{
+				Id: "dp-1",
+			})).Return(&api.GetDeviceProfileResponse{
+				DeviceProfile: &api.DeviceProfile{MacVersion: tst.macVersion},
+			}, nil)
+
+			devices, err := DeriveMcRootKeys(context.Background(), devEUIs, tst.packageVersion)
+			assert.NoError(err)
+			assert.Equal(map[lorawan.EUI64]DeviceOptions{
+				devEUIs[0]: {McRootKey: tst.expected},
+				devEUIs[1]: {McRootKey: tst.expected},
+			}, devices)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package fuota
+
+import (
+	"crypto/aes"
+	"fmt"
+
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/applayer/multicastsetup"
+)
+
+// Remote Multicast Setup package versions.
+const (
+	multicastSetupPackageVersion1 uint8 = 1
+	multicastSetupPackageVersion2 uint8 = 2
+)
+
+// multicastSetupPackageVersion returns the Remote Multicast Setup package
+// version used by the deployment.
+func (d *Deployment) multicastSetupPackageVersion() uint8 {
+	if d.opts.MulticastSetupPackageVersion == multicastSetupPackageVersion2 {
+		return multicastSetupPackageVersion2
+	}
+	return multicastSetupPackageVersion1
+}
+
+// encryptMcKey returns the McKey_encrypted of the McGroupSetupReq for the
+// given McRootKey. Using the v1 package, the device obtains the McKey as
+// aes128_encrypt(McKEKey, McKey_encrypted), the McKey is therefore encrypted
+// using aes128_decrypt. Using the v2 package, the McKey is encrypted as
+// aes128_encrypt(McKEKey, McKey) and decrypted by the device.
+func (d *Deployment) encryptMcKey(mcRootKey lorawan.AES128Key) (lorawan.AES128Key, error) {
+	var mcKeyEncrypted lorawan.AES128Key
+
+	mcKEKey, err := multicastsetup.GetMcKEKey(mcRootKey)
+	if err != nil {
+		return mcKeyEncrypted, fmt.Errorf("GetMcKEKey error: %w", err)
+	}
+	block, err := aes.NewCipher(mcKEKey[:])
+	if err != nil {
+		return mcKeyEncrypted, fmt.Errorf("new cipher error: %w", err)
+	}
+
+	if d.multicastSetupPackageVersion() == multicastSetupPackageVersion2 {
+		block.Encrypt(mcKeyEncrypted[:], d.mcKey[:])
+	} else {
+		block.Decrypt(mcKeyEncrypted[:], d.mcKey[:])
+	}
+
+	return mcKeyEncrypted, nil
+}
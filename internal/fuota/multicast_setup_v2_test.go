@@ -0,0 +1,136 @@
+package fuota
+
+import (
+	"context"
+	"crypto/aes"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/applayer/multicastsetup"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/client/as"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/storage"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/test"
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+)
+
+func TestEncryptMcKey(t *testing.T) {
+	mcRootKey := lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8}
+	mcKey := lorawan.AES128Key{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1}
+
+	mcKEKey, err := multicastsetup.GetMcKEKey(mcRootKey)
+	require.NoError(t, err)
+	block, err := aes.NewCipher(mcKEKey[:])
+	require.NoError(t, err)
+
+	t.Run("v1", func(t *testing.T) {
+		assert := require.New(t)
+
+		d := newDeployment(uuid.Nil, DeploymentOptions{})
+		d.mcKey = mcKey
+
+		mcKeyEncrypted, err := d.encryptMcKey(mcRootKey)
+		assert.NoError(err)
+
+		// the device obtains the McKey using aes128_encrypt
+		var out lorawan.AES128Key
+		block.Encrypt(out[:], mcKeyEncrypted[:])
+		assert.Equal(mcKey, out)
+	})
+
+	t.Run("v2", func(t *testing.T) {
+		assert := require.New(t)
+
+		d := newDeployment(uuid.Nil, DeploymentOptions{MulticastSetupPackageVersion: multicastSetupPackageVersion2})
+		d.mcKey = mcKey
+
+		mcKeyEncrypted, err := d.encryptMcKey(mcRootKey)
+		assert.NoError(err)
+
+		// the device obtains the McKey using aes128_decrypt
+		var out lorawan.AES128Key
+		block.Decrypt(out[:], mcKeyEncrypted[:])
+		assert.Equal(mcKey, out)
+	})
+}
+
+func (s *FUOTATestSuite) TestMulticastSetupV2() {
+	devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+	mcRootKey := lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8}
+
+	newDeploymentV2 := func() *Deployment {
+		d := s.classBDeployment(devEUI)
+		d.opts.MulticastSetupPackageVersion = multicastSetupPackageVersion2
+		d.opts.Devices[devEUI] = DeviceOptions{McRootKey: mcRootKey}
+		d.mcAddr = lorawan.DevAddr{1, 2, 3, 4}
+		d.mcKey = lorawan.AES128Key{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1}
+		return d
+	}
+
+	s.T().Run("McGroupSetupReq", func(t *testing.T) {
+		assert := require.New(t)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mock := test.NewMockDeviceServiceClient(ctrl)
+		as.SetDeviceClient(mock)
+
+		d := newDeploymentV2()
+
+		mcKEKey, err := multicastsetup.GetMcKEKey(mcRootKey)
+		assert.NoError(err)
+		block, err := aes.NewCipher(mcKEKey[:])
+		assert.NoError(err)
+		var mcKeyEncrypted lorawan.AES128Key
+		block.Encrypt(mcKeyEncrypted[:], d.mcKey[:])
+
+		var req *api.EnqueueDeviceQueueItemRequest
+		mock.EXPECT().Enqueue(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, r *api.EnqueueDeviceQueueItemRequest, opts ...interface{}) (*api.EnqueueDeviceQueueItemResponse, error) {
+				req = r
+				return &api.EnqueueDeviceQueueItemResponse{}, nil
+			},
+		)
+
+		assert.NoError(d.stepMulticastSetup(context.Background()))
+		assert.True(d.deviceState[devEUI].getMulticastSetupReq())
+
+		// CID | McGroupIDHeader | McAddr | McKey_encrypted | minMcFCount | maxMcFCount
+		b := []byte{0x02, 0x01, 0x04, 0x03, 0x02, 0x01}
+		b = append(b, mcKeyEncrypted[:]...)
+		b = append(b, 0x00, 0x00, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff)
+		assert.Equal(b, req.GetQueueItem().GetData())
+	})
+
+	s.T().Run("McGroupSetupAns IDError", func(t *testing.T) {
+		assert := require.New(t)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mock := test.NewMockDeviceServiceClient(ctrl)
+		as.SetDeviceClient(mock)
+
+		d := newDeploymentV2()
+
+		// the multicast-group occupying the McGroupID might belong to
+		// another multicast context and must not be deleted
+		mock.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Times(0)
+
+		assert.NoError(d.handleMcGroupSetupAns(context.Background(), devEUI, &multicastsetup.McGroupSetupAnsPayload{
+			McGroupIDHeader: multicastsetup.McGroupSetupAnsPayloadMcGroupIDHeader{
+				IDError:   true,
+				McGroupID: 1,
+			},
+		}))
+		assert.False(d.deviceState[devEUI].getMulticastSetup())
+
+		dd, err := storage.GetDeploymentDevice(context.Background(), storage.DB(), d.GetID(), devEUI)
+		assert.NoError(err)
+		assert.Equal(storage.FailureReasonMcGroupSetupIDError, dd.FailureReason)
+	})
+}
@@ -0,0 +1,338 @@
+package fuota
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq/hstore"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/applayer/clocksync"
+	"github.com/brocaar/lorawan/applayer/firmwaremanagement"
+	"github.com/brocaar/lorawan/applayer/fragmentation"
+	"github.com/brocaar/lorawan/applayer/multicastsetup"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/client/as"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/storage"
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+)
+
+// ErrNoCompatibleDevices is returned when none of the devices implements
+// compatible package versions.
+var ErrNoCompatibleDevices = errors.New("no devices with compatible package versions")
+
+// requiredPackage defines an application-layer package that must be
+// implemented by the devices.
+type requiredPackage struct {
+	fPort      uint8
+	identifier uint8
+	versions   []uint8
+}
+
+// supports returns true when the given package identifier and version are
+// supported.
+func (p requiredPackage) supports(identifier, version uint8) bool {
+	if identifier != p.identifier {
+		return false
+	}
+
+	for _, v := range p.versions {
+		if v == version {
+			return true
+		}
+	}
+
+	return false
+}
+
+// requiredPackages returns the packages used by the deployment, with the
+// package identifiers as assigned by the LoRa Alliance. The multicast setup
+// and fragmentation package versions depend on the deployment options. The
+// Firmware Management package is only required when firmware management is
+// used.
+func (d *Deployment) requiredPackages() []requiredPackage {
+	packages := []requiredPackage{
+		{fPort: clocksync.DefaultFPort, identifier: 1, versions: []uint8{1}},
+		{fPort: multicastsetup.DefaultFPort, identifier: 2, versions: []uint8{d.multicastSetupPackageVersion()}},
+		{fPort: fragmentation.DefaultFPort, identifier: 3, versions: []uint8{d.fragmentationPackageVersion()}},
+	}
+
+	if d.opts.FirmwareManagement {
+		packages = append(packages, requiredPackage{fPort: firmwaremanagement.DefaultFPort, identifier: 4, versions: []uint8{1}})
+	}
+
+	return packages
+}
+
+// getRequiredPackage returns the required package for the given fPort.
+func (d *Deployment) getRequiredPackage(fPort uint8) (requiredPackage, bool) {
+	for _, p := range d.requiredPackages() {
+		if p.fPort == fPort {
+			return p, true
+		}
+	}
+
+	return requiredPackage{}, false
+}
+
+// handleClockSyncCommand handles an uplink clock sync command. Note that
+// the AppTimeReq is handled by the eventhandler as it is not related to a
+// deployment.
+func (d *Deployment) handleClockSyncCommand(ctx context.Context, devEUI lorawan.EUI64, b []byte) error {
+	var cmd clocksync.Command
+	if err := cmd.UnmarshalBinary(true, b); err != nil {
+		return fmt.Errorf("unmarshal command error: %w", err)
+	}
+
+	switch cmd.CID {
+	case clocksync.PackageVersionAns:
+		pl, ok := cmd.Payload.(*clocksync.PackageVersionAnsPayload)
+		if !ok {
+			return fmt.Errorf("expected *clocksync.PackageVersionAnsPayload, got: %T", cmd.Payload)
+		}
+		return d.handlePackageVersionAns(ctx, devEUI, clocksync.DefaultFPort, pl.PackageIdentifier, pl.PackageVersion)
+	}
+
+	return nil
+}
+
+func (d *Deployment) handlePackageVersionAns(ctx context.Context, devEUI lorawan.EUI64, fPort, identifier, version uint8) error {
+	log.WithFields(log.Fields{
+		"deployment_id":      d.GetID(),
+		"dev_eui":            devEUI,
+		"f_port":             fPort,
+		"package_identifier": identifier,
+		"package_version":    version,
+	}).Info("fuota: PackageVersionAns received")
+
+	dl := storage.DeploymentLog{
+		DeploymentID: d.GetID(),
+		DevEUI:       devEUI,
+		FPort:        fPort,
+		Command:      "PackageVersionAns",
+		Fields: hstore.Hstore{
+			Map: map[string]sql.NullString{
+				"package_identifier": sql.NullString{Valid: true, String: fmt.Sprintf("%d", identifier)},
+				"package_version":    sql.NullString{Valid: true, String: fmt.Sprintf("%d", version)},
+			},
+		},
+	}
+	d.createDeploymentLog(ctx, EventTypeDeviceAnswer, &dl)
+
+	if !d.opts.RequestPackageVersions {
+		return nil
+	}
+
+	// ignore answers of devices that already completed or have been excluded
+	state, ok := d.deviceState[devEUI]
+	if !ok || state.getExcluded() || state.getPackageVersionDone() {
+		return nil
+	}
+
+	p, ok := d.getRequiredPackage(fPort)
+	if !ok {
+		return nil
+	}
+
+	if !p.supports(identifier, version) {
+		log.WithFields(log.Fields{
+			"deployment_id":      d.GetID(),
+			"dev_eui":            devEUI,
+			"package_identifier": identifier,
+			"package_version":    version,
+		}).Warning("fuota: device package version is not compatible, excluding device")
+
+		if err := d.excludeDevice(ctx, devEUI, storage.FailureReasonPackageVersionIncompatible); err != nil {
+			return err
+		}
+		d.checkPackageVersionDone()
+
+		return nil
+	}
+
+	state.setPackageVersion(fPort, version)
+	for _, p := range d.requiredPackages() {
+		if _, ok := state.getPackageVersion(p.fPort); !ok {
+			return nil
+		}
+	}
+	state.setPackageVersionDone(true)
+
+	dd, err := storage.GetDeploymentDevice(ctx, storage.DB(), d.GetID(), devEUI)
+	if err != nil {
+		return fmt.Errorf("get deployment device error: %w", err)
+	}
+	now := time.Now()
+	dd.PackageVersionCompletedAt = &now
+	if err := storage.UpdateDeploymentDevice(ctx, storage.DB(), &dd); err != nil {
+		return fmt.Errorf("update deployment device error: %w", err)
+	}
+
+	d.checkPackageVersionDone()
+
+	return nil
+}
+
+// excludeDevice excludes the given device from the remaining steps of the
+// deployment and persists the failure reason.
+func (d *Deployment) excludeDevice(ctx context.Context, devEUI lorawan.EUI64, reason storage.DeploymentDeviceFailureReason) error {
+	d.deviceState[devEUI].setExcluded(true)
+	return d.setDeviceFailureReason(ctx, devEUI, reason)
+}
+
+// checkPackageVersionDone publishes to the package version done chan when
+// all devices have either reported compatible package versions or have been
+// excluded.
+func (d *Deployment) checkPackageVersionDone() {
+	for _, state := range d.deviceState {
+		if !state.getExcluded() && !state.getPackageVersionDone() {
+			return
+		}
+	}
+
+	// the channel is buffered, do not block when an answer is received after
+	// the package version step has completed
+	select {
+	case d.packageVersionDone <- struct{}{}:
+	default:
+	}
+}
+
+// Request the package versions of the Clock Sync, Multicast Setup,
+// Fragmentation and (when used) Firmware Management packages. Devices that do
+// not answer or that report an incompatible version are excluded from the
+// deployment.
+func (d *Deployment) stepPackageVersion(ctx context.Context) error {
+	log.WithField("deployment_id", d.GetID()).Info("fuota: requesting package versions from devices")
+
+	attempt := 0
+
+devLoop:
+	for {
+		attempt += 1
+		if attempt > d.opts.UnicastAttemptCount {
+			log.WithField("deployment_id", d.GetID()).Warning("fuota: package version request reached max. number of attempts, some devices did not complete")
+			break
+		}
+
+		for devEUI := range d.opts.Devices {
+			state := d.deviceState[devEUI]
+			if state.getExcluded() || state.getPackageVersionDone() {
+				continue
+			}
+
+			log.WithFields(log.Fields{
+				"deployment_id": d.GetID(),
+				"dev_eui":       devEUI,
+				"attempt":       attempt,
+			}).Info("fuota: request package versions from device")
+
+			for _, p := range d.requiredPackages() {
+				if _, ok := state.getPackageVersion(p.fPort); ok {
+					continue
+				}
+
+				if err := d.enqueuePackageVersionReq(ctx, devEUI, p.fPort); err != nil {
+					return err
+				}
+			}
+		}
+
+		select {
+		// sleep until next retry
+		case <-time.After(d.opts.UnicastTimeout):
+			continue devLoop
+		case <-ctx.Done():
+			return ctx.Err()
+		// terminate when all devices have reported their package versions
+		case <-d.packageVersionDone:
+			log.WithField("deployment_id", d.GetID()).Info("fuota: package versions received for all devices")
+			break devLoop
+		}
+	}
+
+	compatible := 0
+	for devEUI := range d.opts.Devices {
+		state := d.deviceState[devEUI]
+		if state.getExcluded() {
+			continue
+		}
+
+		if !state.getPackageVersionDone() {
+			log.WithFields(log.Fields{
+				"deployment_id": d.GetID(),
+				"dev_eui":       devEUI,
+			}).Warning("fuota: device did not report its package versions, excluding device")
+
+			if err := d.excludeDevice(ctx, devEUI, storage.FailureReasonPackageVersionNoAnswer); err != nil {
+				return err
+			}
+			continue
+		}
+
+		compatible++
+	}
+
+	if compatible == 0 {
+		return ErrNoCompatibleDevices
+	}
+
+	sd, err := storage.GetDeployment(ctx, storage.DB(), d.GetID())
+	if err != nil {
+		return fmt.Errorf("get deployment error: %w", err)
+	}
+	now := time.Now()
+	sd.PackageVersionCompletedAt = &now
+	if err := storage.UpdateDeployment(ctx, storage.DB(), &sd); err != nil {
+		return fmt.Errorf("update deployment error: %w", err)
+	}
+
+	return nil
+}
+
+// enqueuePackageVersionReq enqueues a PackageVersionReq on the given package
+// fPort to the given device.
+func (d *Deployment) enqueuePackageVersionReq(ctx context.Context, devEUI lorawan.EUI64, fPort uint8) error {
+	var b []byte
+	var err error
+
+	switch fPort {
+	case clocksync.DefaultFPort:
+		b, err = clocksync.Command{CID: clocksync.PackageVersionReq}.MarshalBinary()
+	case multicastsetup.DefaultFPort:
+		b, err = multicastsetup.Command{CID: multicastsetup.PackageVersionReq}.MarshalBinary()
+	case fragmentation.DefaultFPort:
+		b, err = fragmentation.Command{CID: fragmentation.PackageVersionReq}.MarshalBinary()
+	case firmwaremanagement.DefaultFPort:
+		b, err = firmwaremanagement.Command{CID: firmwaremanagement.PackageVersionReq}.MarshalBinary()
+	default:
+		return fmt.Errorf("unexpected package fPort: %d", fPort)
+	}
+	if err != nil {
+		return fmt.Errorf("marshal binary error: %w", err)
+	}
+
+	_, err = as.DeviceClient().Enqueue(ctx, &api.EnqueueDeviceQueueItemRequest{
+		QueueItem: &api.DeviceQueueItem{
+			DevEui: devEUI.String(),
+			FPort:  uint32(fPort),
+			Data:   b,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("enqueue payload error: %w", err)
+	}
+
+	dl := storage.DeploymentLog{
+		DeploymentID: d.GetID(),
+		DevEUI:       devEUI,
+		FPort:        fPort,
+		Command:      "PackageVersionReq",
+	}
+	d.createDeploymentLog(ctx, EventTypeDeploymentLog, &dl)
+
+	return nil
+}
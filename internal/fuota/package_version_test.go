@@ -0,0 +1,158 @@
+package fuota
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/applayer/clocksync"
+	"github.com/brocaar/lorawan/applayer/firmwaremanagement"
+	"github.com/brocaar/lorawan/applayer/fragmentation"
+	"github.com/brocaar/lorawan/applayer/multicastsetup"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/client/as"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/storage"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/test"
+	"github.com/chirpstack/chirpstack/api/go/v4/api"
+)
+
+func (s *FUOTATestSuite) TestPackageVersion() {
+	assert := require.New(s.T())
+
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	mock := test.NewMockDeviceServiceClient(ctrl)
+	as.SetDeviceClient(mock)
+
+	devEUI1 := lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}
+	devEUI2 := lorawan.EUI64{2, 2, 2, 2, 2, 2, 2, 2}
+	devEUI3 := lorawan.EUI64{3, 3, 3, 3, 3, 3, 3, 3}
+
+	d, err := NewDeployment(DeploymentOptions{
+		ApplicationID: "app-1",
+		Devices: map[lorawan.EUI64]DeviceOptions{
+			devEUI1: {},
+			devEUI2: {},
+			devEUI3: {},
+		},
+		MulticastGroupType:     api.MulticastGroupType_CLASS_C,
+		UnicastTimeout:         100 * time.Millisecond,
+		UnicastAttemptCount:    1,
+		RequestPackageVersions: true,
+	})
+	assert.NoError(err)
+
+	// devEUI1 implements the required versions, devEUI2 implements an
+	// unsupported fragmentation version and devEUI3 does not answer.
+	versions := map[lorawan.EUI64]uint8{
+		devEUI1: 1,
+		devEUI2: 2,
+	}
+
+	mock.EXPECT().Enqueue(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, r *api.EnqueueDeviceQueueItemRequest, opts ...interface{}) (*api.EnqueueDeviceQueueItemResponse, error) {
+			assert.Equal([]byte{0x00}, r.GetQueueItem().GetData())
+
+			var devEUI lorawan.EUI64
+			assert.NoError(devEUI.UnmarshalText([]byte(r.GetQueueItem().GetDevEui())))
+
+			version, ok := versions[devEUI]
+			if !ok {
+				return &api.EnqueueDeviceQueueItemResponse{}, nil
+			}
+
+			fPort := uint8(r.GetQueueItem().GetFPort())
+			p, ok := d.getRequiredPackage(fPort)
+			assert.True(ok)
+			if fPort != fragmentation.DefaultFPort {
+				version = 1
+			}
+
+			assert.NoError(d.handlePackageVersionAns(ctx, devEUI, fPort, p.identifier, version))
+			return &api.EnqueueDeviceQueueItemResponse{}, nil
+		},
+	).MinTimes(5)
+
+	assert.NoError(d.stepPackageVersion(context.Background()))
+
+	assert.False(d.deviceState[devEUI1].getExcluded())
+	assert.True(d.deviceState[devEUI1].getPackageVersionDone())
+	assert.True(d.deviceState[devEUI2].getExcluded())
+	assert.True(d.deviceState[devEUI3].getExcluded())
+
+	dd, err := storage.GetDeploymentDevice(context.Background(), storage.DB(), d.GetID(), devEUI1)
+	assert.NoError(err)
+	assert.NotNil(dd.PackageVersionCompletedAt)
+	assert.Equal(storage.FailureReasonNone, dd.FailureReason)
+
+	dd, err = storage.GetDeploymentDevice(context.Background(), storage.DB(), d.GetID(), devEUI2)
+	assert.NoError(err)
+	assert.Nil(dd.PackageVersionCompletedAt)
+	assert.Equal(storage.FailureReasonPackageVersionIncompatible, dd.FailureReason)
+
+	dd, err = storage.GetDeploymentDevice(context.Background(), storage.DB(), d.GetID(), devEUI3)
+	assert.NoError(err)
+	assert.Equal(storage.FailureReasonPackageVersionNoAnswer, dd.FailureReason)
+
+	sd, err := storage.GetDeployment(context.Background(), storage.DB(), d.GetID())
+	assert.NoError(err)
+	assert.NotNil(sd.PackageVersionCompletedAt)
+
+	// the excluded devices are not part of the deployment when resumed
+	d, err = LoadDeployment(context.Background(), d.GetID())
+	assert.NoError(err)
+	assert.False(d.deviceState[devEUI1].getExcluded())
+	assert.True(d.deviceState[devEUI2].getExcluded())
+	assert.True(d.deviceState[devEUI3].getExcluded())
+}
+
+func TestRequiredPackages(t *testing.T) {
+	v2 := DeploymentOptions{
+		MulticastSetupPackageVersion: 2,
+		FragmentationPackageVersion:  2,
+	}
+
+	tests := []struct {
+		name       string
+		opts       DeploymentOptions
+		fPort      uint8
+		identifier uint8
+		version    uint8
+		supported  bool
+	}{
+		{"clocksync v1", DeploymentOptions{}, clocksync.DefaultFPort, 1, 1, true},
+		{"multicast setup v1", DeploymentOptions{}, multicastsetup.DefaultFPort, 2, 1, true},
+		{"multicast setup v2", DeploymentOptions{}, multicastsetup.DefaultFPort, 2, 2, false},
+		{"fragmentation v1", DeploymentOptions{}, fragmentation.DefaultFPort, 3, 1, true},
+		{"fragmentation v2", DeploymentOptions{}, fragmentation.DefaultFPort, 3, 2, false},
+		{"multicast setup v1 deployment v2", v2, multicastsetup.DefaultFPort, 2, 1, false},
+		{"multicast setup v2 deployment v2", v2, multicastsetup.DefaultFPort, 2, 2, true},
+		{"fragmentation v1 deployment v2", v2, fragmentation.DefaultFPort, 3, 1, false},
+		{"fragmentation v2 deployment v2", v2, fragmentation.DefaultFPort, 3, 2, true},
+		{"firmware management v1", DeploymentOptions{FirmwareManagement: true}, firmwaremanagement.DefaultFPort, 4, 1, true},
+		{"wrong identifier", DeploymentOptions{}, fragmentation.DefaultFPort, 2, 1, false},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.name, func(t *testing.T) {
+			assert := require.New(t)
+
+			d := newDeployment(uuid.Nil, tst.opts)
+
+			p, ok := d.getRequiredPackage(tst.fPort)
+			assert.True(ok)
+			assert.Equal(tst.supported, p.supports(tst.identifier, tst.version))
+		})
+	}
+
+	d := newDeployment(uuid.Nil, DeploymentOptions{})
+	_, ok := d.getRequiredPackage(1)
+	require.False(t, ok)
+	_, ok = d.getRequiredPackage(firmwaremanagement.DefaultFPort)
+	require.False(t, ok)
+}
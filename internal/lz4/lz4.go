@@ -0,0 +1,190 @@
+// Package lz4 implements the LZ4 block format. The compressed block is
+// prefixed by the uncompressed size (4 bytes, little endian), so that the
+// block can be decompressed on the device using LZ4_decompress_safe without
+// knowing the image size upfront.
+package lz4
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+const (
+	// minMatch defines the min. match length of the LZ4 format.
+	minMatch = 4
+
+	// lastLiterals defines the number of bytes at the end of the block which
+	// must be encoded as literals.
+	lastLiterals = 5
+
+	// mfLimit defines the min. distance between the start of the last match
+	// and the end of the block.
+	mfLimit = 12
+
+	// maxOffset defines the max. offset of a match.
+	maxOffset = 65535
+
+	// hashLog defines the size (in bits) of the match-finder hash table.
+	hashLog = 16
+)
+
+// ErrInvalidBlock is returned when the block can not be decompressed.
+var ErrInvalidBlock = errors.New("invalid lz4 block")
+
+// Compress returns the size-prefixed LZ4 block of the given data.
+func Compress(src []byte) []byte {
+	dst := make([]byte, 4, len(src)/2+16)
+	binary.LittleEndian.PutUint32(dst, uint32(len(src)))
+
+	// the table contains the position + 1 of the last occurrence of a hash,
+	// 0 indicates no occurrence
+	var table [1 << hashLog]int
+
+	anchor := 0
+	for i := 0; i+mfLimit <= len(src); {
+		seq := binary.LittleEndian.Uint32(src[i:])
+		h := hash(seq)
+		ref := table[h] - 1
+		table[h] = i + 1
+
+		if ref < 0 || i-ref > maxOffset || binary.LittleEndian.Uint32(src[ref:]) != seq {
+			i++
+			continue
+		}
+
+		n := minMatch
+		for i+n < len(src)-lastLiterals && src[ref+n] == src[i+n] {
+			n++
+		}
+
+		dst = appendSequence(dst, src[anchor:i], i-ref, n)
+		i += n
+		anchor = i
+	}
+
+	// the last sequence only contains literals
+	lits := src[anchor:]
+	dst = append(dst, byte(min(len(lits), 15))<<4)
+	if len(lits) >= 15 {
+		dst = appendLength(dst, len(lits)-15)
+	}
+	dst = append(dst, lits...)
+
+	return dst
+}
+
+// Decompress decompresses the given size-prefixed LZ4 block.
+func Decompress(src []byte) ([]byte, error) {
+	if len(src) < 4 {
+		return nil, ErrInvalidBlock
+	}
+
+	size := int(binary.LittleEndian.Uint32(src))
+	dst := make([]byte, 0, size)
+
+	for i := 4; i < len(src); {
+		token := src[i]
+		i++
+
+		litLen := int(token >> 4)
+		if litLen == 15 {
+			n, err := readLength(src, &i)
+			if err != nil {
+				return nil, err
+			}
+			litLen += n
+		}
+
+		if i+litLen > len(src) || len(dst)+litLen > size {
+			return nil, ErrInvalidBlock
+		}
+		dst = append(dst, src[i:i+litLen]...)
+		i += litLen
+
+		// the last sequence only contains literals
+		if i == len(src) {
+			break
+		}
+
+		if i+2 > len(src) {
+			return nil, ErrInvalidBlock
+		}
+		offset := int(binary.LittleEndian.Uint16(src[i:]))
+		i += 2
+
+		matchLen := int(token & 0x0f)
+		if matchLen == 15 {
+			n, err := readLength(src, &i)
+			if err != nil {
+				return nil, err
+			}
+			matchLen += n
+		}
+		matchLen += minMatch
+
+		if offset == 0 || offset > len(dst) || len(dst)+matchLen > size {
+			return nil, ErrInvalidBlock
+		}
+
+		// byte by byte as the match might overlap with the output
+		for j := 0; j < matchLen; j++ {
+			dst = append(dst, dst[len(dst)-offset])
+		}
+	}
+
+	if len(dst) != size {
+		return nil, ErrInvalidBlock
+	}
+
+	return dst, nil
+}
+
+func hash(seq uint32) uint32 {
+	return (seq * 2654435761) >> (32 - hashLog)
+}
+
+// appendSequence appends the sequence of the given literals, followed by a
+// match of the given offset and length.
+func appendSequence(dst, lits []byte, offset, matchLen int) []byte {
+	ml := matchLen - minMatch
+
+	dst = append(dst, byte(min(len(lits), 15))<<4|byte(min(ml, 15)))
+	if len(lits) >= 15 {
+		dst = appendLength(dst, len(lits)-15)
+	}
+	dst = append(dst, lits...)
+	dst = append(dst, byte(offset), byte(offset>>8))
+	if ml >= 15 {
+		dst = appendLength(dst, ml-15)
+	}
+
+	return dst
+}
+
+// appendLength appends the additional length bytes.
+func appendLength(dst []byte, n int) []byte {
+	for n >= 255 {
+		dst = append(dst, 255)
+		n -= 255
+	}
+	return append(dst, byte(n))
+}
+
+// readLength reads the additional length bytes starting at the given
+// position.
+func readLength(src []byte, i *int) (int, error) {
+	n := 0
+	for {
+		if *i >= len(src) {
+			return 0, ErrInvalidBlock
+		}
+
+		b := src[*i]
+		*i++
+		n += int(b)
+
+		if b != 255 {
+			return n, nil
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package lz4
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompress(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	random := make([]byte, 10000)
+	rnd.Read(random)
+
+	tests := []struct {
+		name    string
+		data    []byte
+		maxSize int
+	}{
+		{"empty", nil, 5},
+		{"short", []byte("hello"), 10},
+		{"repeated byte", bytes.Repeat([]byte{0x00}, 100000), 450},
+		{"repeated text", bytes.Repeat([]byte("this is the firmware image. "), 1000), 200},
+		{"random", random, 10050},
+		{"random repeated", append(append([]byte{}, random...), random...), 10100},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.name, func(t *testing.T) {
+			assert := require.New(t)
+
+			b := Compress(tst.data)
+			assert.LessOrEqual(len(b), tst.maxSize)
+
+			out, err := Decompress(b)
+			assert.NoError(err)
+			assert.Equal(len(tst.data), len(out))
+			assert.True(bytes.Equal(tst.data, out))
+		})
+	}
+}
+
+func TestDecompress(t *testing.T) {
+	tests := []struct {
+		name  string
+		block []byte
+		data  []byte
+		err   error
+	}{
+		{
+			name:  "literals only",
+			block: []byte{0x05, 0x00, 0x00, 0x00, 0x50, '1', '2', '3', '4', '5'},
+			data:  []byte("12345"),
+		},
+		{
+			name:  "overlapping match",
+			block: []byte{0x10, 0x00, 0x00, 0x00, 0x34, 'a', 'b', 'c', 0x03, 0x00, 0x50, '1', '2', '3', '4', '5'},
+			data:  []byte("abcabcabcab12345"),
+		},
+		{
+			name:  "size mismatch",
+			block: []byte{0x06, 0x00, 0x00, 0x00, 0x50, '1', '2', '3', '4', '5'},
+			err:   ErrInvalidBlock,
+		},
+		{
+			name:  "invalid offset",
+			block: []byte{0x10, 0x00, 0x00, 0x00, 0x34, 'a', 'b', 'c', 0x04, 0x00, 0x50, '1', '2', '3', '4', '5'},
+			err:   ErrInvalidBlock,
+		},
+		{
+			name:  "truncated",
+			block: []byte{0x05, 0x00, 0x00, 0x00, 0x50, '1', '2'},
+			err:   ErrInvalidBlock,
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.name, func(t *testing.T) {
+			assert := require.New(t)
+
+			out, err := Decompress(tst.block)
+			assert.Equal(tst.err, err)
+			if tst.err == nil {
+				assert.Equal(tst.data, out)
+			}
+		})
+	}
+}
@@ -8,7 +8,7 @@ import (
 
 
 func init() {
-	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00l}\x82Q\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00000001_initial.down.sqlUT\x05\x00\x01\x1d\xb6\xc7_J)\xca/P\xc8\xccKI\xadP\xc8L\xa9\x88OI-\xc8\xc9\xaf\xccM\xcd+\x89\xcf\xc9O\x8fOI-\x8bO-\xcd\xb4\xe6\"\xa4\x0c\xce\xcdL\xb1\xe6\x82\xa8.IL\xcaIU@Ui\x8dC*%\xb5,39\x15\x87\xac5\x17 \x00\x00\xff\xffPK\x07\x08\xc2\x9e\xaa]L\x00\x00\x00\xa5\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00D\x82\x83Q\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x15\x00	\x00000001_initial.up.sqlUT\x05\x00\x01\xc0\x0f\xc9_\xd4\x93\xcbn\xc20\x10E\xf7\xf9\x8aY\x12\x89E7]\xf11\xd6`_\x82U\xbfj\x8f)\xe9\xd7W%\xd0:\xa8\xaf\xb0@\xea2\x8fsm\xcf\xb9\xd6\x19, \xe1\xad\x03\x19$\x17G\x8f \xb4\xea\x88\x88\xac\xa1Z\xad\xa1\x94\xad\xe7<\xd2\x13F\nQ(T\xe7\xd6\xa7?&\xde(\x16\x12\xebQ\x84}\xa2\x17+\xfb\xd3#\xbd\xc6\x80+\xa2&\xb3\x90\xf0Z\x0d9\xd6\xa4\n\xa4&\xa5\xa3O\x0e\xbfF\xb4xA)6\x86\xc5\xe8.\xf3\xf0\x01\xdf\xb6:\xc2sE\xc5bnZZXjY\xc0v\xfd\xa6\xeb\xbeq\xaa\x0c\x0eV\xe3\xac\xb6y\x7f\xb1|\x99:e\xec\x90\x114J[\x89\x18\xc8\xe0}\x1b\xa4\xb9h6X\x9f\x83\x0e\n\xd5\xd2v\x14\xf0\x9d\xdb\xf1\xff\xebq\x9b\xe6\xf3\xc9\xdbk\xb9\x9a	]_\xb4\xf4?6\xc2\xc5\xe1\xf3\xa6o\xedP\x90-\xbb6\xf7V\x8fw\xa9\xd7N\xa5\x98\x85\x8ag\xe7l\x90\xeb\xf2E\xef9\x18:p\xd6{\xce\xab\xc7\x87\xfe\x9a\xb7p\xa6\xd0\xbeH\xcc\xa0vR6\x18\x1c\xc9\x9a\xa3\x9aOK\xcd\xcfu\xdas\xfb}n\xa1\xdf\xfc!o:\xdeWI\x93\xc0M\xf7\x16\x00\x00\xff\xffPK\x07\x08\x8fM!\xdb.\x01\x00\x00\xa4\x05\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00l}\x82Q\xc2\x9e\xaa]L\x00\x00\x00\xa5\x00\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x00\x00\x00\x00000001_initial.down.sqlUT\x05\x00\x01\x1d\xb6\xc7_PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00D\x82\x83Q\x8fM!\xdb.\x01\x00\x00\xa4\x05\x00\x00\x15\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x9a\x00\x00\x00000001_initial.up.sqlUT\x05\x00\x01\xc0\x0f\xc9_PK\x05\x06\x00\x00\x00\x00\x02\x00\x02\x00\x9a\x00\x00\x00\x14\x02\x00\x00\x00\x00"
+	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\xaepI\\\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00000001_initial.down.sqlUT\x05\x00\x01\xa9\xe9\x89iJ)\xca/P\xc8\xccKI\xadP\xc8L\xa9\x88OI-\xc8\xc9\xaf\xccM\xcd+\x89\xcf\xc9O\x8fOI-\x8bO-\xcd\xb4\xe6\"\xa4\x0c\xce\xcdL\xb1\xe6\x82\xa8.IL\xcaIU@5\xd0\x1a\x87TJjYfr*\x0eYk.\xc0\x00PK\x07\x08\xc2\x9e\xaa]I\x00\x00\x00\xa5\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xaepI\\\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x15\x00	\x00000001_initial.up.sqlUT\x05\x00\x01\xa9\xe9\x89i\xd4\x93\xbd\x8e\xdb0\x10\x84{=\xc5\x96\x16\xe0\"M*?\x0c\xb1&\xc7\xf2\"\xfc\x0b\xb9T\xac<}\x10\xff\xc4\x8c\x91\xdc\x9dU\x18\xb8\x92 g\x88\xddo\xc6\x16\xb0\x82\x94\xf7\x1e\xe4\x90}Z\x02\xa2\xd2f \"\x12G\xad\x89\xa3\\$pY\xe8\x1b\x16\x8aI)6\xef\xb7\xe7\x17\x17\xbd3\xac\xa4\x12P\x95C\xa6\x1f\xa2\xc7\xf3\x91~\xa6\x88\x07E\xcb\xeeIE\xb0f*\xa9eS\xa1-\x1b\x9bB\xf6x\xd7\xa2\x97W\xd4*)>-=\x14\x9e\xfe\x88\xd7\xfd\x8e\xf8\xbd\xa1a\xe5\xd7\xca\xda\xea\x13\xdaa\xdc\x0d\xc3\x7f\x98\x1a\x87Y,\xaeh\xef\xac\xcd\x8d\xf2\x8d\x13\x15\x1cP\x10-j\x1f\x89\x14\xc9\xe1\xf7\xe2\xc9r\xb5\xec\xb0\xbd\x1a\xcd\x06Mh\xbf(\xf8\xc5\xe9\xf8\xfc\xf1X\x87\xf9:y_\xcb\xcd\x9d\x94\x11\xb7\xbda\x19\xdfL\x84O\xd3\xbd\xe9{\x99*\x8a\xb0\xef}\xd7\xb6\xfc%\xf1:\x98\x9c\x8aR\x0d\xec\xbdD}\xb8\xb5)\x04\x8e\x8ef.\xf6\xc8e\xf3\xf5\xcb\xf8\xa8\x17xW\xe9X5\x15P\xbf)\x89\x0e'\x12w2\xdd >M\xfdQ\xdc\xa5\x12\xfd\xfd\xdf\x14\xc6\xdd\x07\xfc.\xe3\xfd\xcbi6h2\xee\x86_\x03\x00PK\x07\x08\x8fM!\xdb2\x01\x00\x00\xa4\x05\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00p\x93P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00!\x00	\x00000002_deployment_resume.down.sqlUT\x05\x00\x01\x95l\xd2j\x84\x93Qn\xc3 \x0c\x86\xdfs\n\x0e\xb0\x1b\xf40\x96k\xbb\x91U\x82\x19\x98\xa9\xd9\xe9\xa7\xa4\xeb\xd4)\x94\xbe\x86\x0f\xdb\xe4\xf3\x8f\xd1\xa5\x04\xc7s\x94\xc0\x92\xa3\xad\x8b$\x07\x96/%\x99B\x08\x81\x8b\xe5@\x16\xdb\x92\xc2BP\xcc\x1c\xae\xb2\x9e\xa6i?\xd1\xc4r\x0b\xca7x\xbaN\xb6\xe4(.\x0c\xe8\xa7i\xea79T\xc7\x9c\xa3\x12\xbaZ\x02\xe5\x8fc\xf7\x16]	\xab\xc3\\\xace\xf05\xcb\x88\xe22:\xcd\x9af\xa8\xd1\x1c\xb2\x145VR_G\x17.E>\x9b$\xeaA\xf4;\xd2xl\xd7E\xac\xf9\x08)2\xab\xa5#\xd1\xd2\x9b\x12\x0f\x00\xdde\xc9\x9b\x82\x96:\x9d.\x05g\xa8\xfa\xdd\xf9q\x19\xd7h\xd8y@\x11n\x89\xb1\xfb\xf2\xad\xde&\xf3n\xadJ\xad\xbb\xbdm)\xde\xc1\x0bz\xd1\x0eu\x8eFW@\xba\x02K\xc4NO\x96JE\xb3[\xc7\xef\xee\xa8:\xf4\xe7\xaa\x8e\xde\xeaH\xd1@#\x01rw\xa5h\xcb\xc3\xf1\xfbS\xd3rw\xff\x9a\x91\xc4/\x88\xbf\xd5\xbaG\xb2nC\xec\xb9\x1a\xa2\x8f\xf0\x1d\xa1\xff\xd1\xfc\x19\x00PK\x07\x08\xe1\x9b7k\x1a\x01\x00\x00\xff\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00p\x93P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1f\x00	\x00000002_deployment_resume.up.sqlUT\x05\x00\x01\x95l\xd2j\xa4U\xc1n\xdb0\x0c\xbd\xfb+xk\x0b\xacC\xb7\x02\xbb\x04\xbb\xed3\x06\x08\xb4\xc88DeI\xa5\xa8\xb6\xee\xd7\x0fNZ/i\xb2\xc2\xf6t0 X\xef\x89z|O\xc2`\xac`\xd8\x06\x06\xe2\x1c\xd2\xd0s\xb4\x06\x00\x00\x89\xc0\xa7P\xfb\x08\x98s\x10\x8f&):!xB\xf5;\xd4\xeb\xfb\x1f7\x10\x93A\xac!\x00\xf1\x16k0\xb8\xba\xfa\xf2\x11\xde\xd7`\xe2\xb1\x98\xeb4\xd5\xecl\xc8<\x91|\xbb[JB\n\xa5\xc7\x10$\xda9\xf2\xee\x93\xdd\xb3\xc4\xce\x95\x90\xcceVI$^lX\xc9\xb5U~\xac\x1c\xfd\x00\xadts+\xf1o\x02\x08\xad\xdc\xd5\xa4\xe7Tm%Z\xb9\x93\x14'\xe5\xbf\xcfT\xbe\xc6\xd3\xcd\xe7\x1f\xf8\x1d\x89f\xdcgs>\xd5h \xd1\xb8c\x9d\xd3\xbb\xadb\xe7\x8a\xbc\xf2\x12P\xc6!$$h\x07c\xdc/>\xf3\x842\xd5H8\xb6o\x01\xf1X\xcd\x18\x8fC\x10\n\x97\xb2\x0fD$~Y\xd4\x91S\x9e\x1eMe\x19A\x1b\x92\x7fp\xe8\x1f\x1cq\xc0e\x16&.^%[\xd2\xcf\x05z\xac\\\xcc]>r1\xb4Z&#\xdd/\x8e\xf0\x14\x83\xc5W\x89wH4\x95\xfeQ\xeb\xab\xdf/wo\xe3\xc2\x0d\xe2\xdd\x03\x0f3\xa0\xff\x1a\xe7\x94G\x82\xe8!\x9d0~\x8aa\x9f\xe1Yl\xb7\x9f\xc2k\x8a|\xd9\x88\xef\x04\x1ci\x0d|\xbaQ\x88\x9f\xc4s\x19\xc5arh\xeby\x02\xdb\x1a\x06\x9f\xfa<\x0f\xbai\x9a\xdb[\xf85=6\x05\xbc2\x1a\x13\xb4\xbcM\xca`;)\xd0K\xa7\xfb\x9c\x81\xc7\xb8\xefV\xcb\xa0\\j\xcf\x04X\xc0v,:\x12\xa5<\xae*\xf0\xcc\xca\x10\xf9\x89\x15\x8a%e\xfa\xda\xd4Lh\xc7\xef\x1a\x14\xb6\xd3J\x7f\xc2a\xd18\xd94\xcd\xa1\x128DZ\xe8\xc5\xfd\xc5\xba\x13\\\x8aG\xb4\xd7\xc7\xbfn6Ms\xf9U}k\xd2\x05[jJ\xf6\xbf\xde\xdc4\x7f\x06\x00PK\x07\x08\x007\xe2\xe2\xe5\x01\x00\x00\xcc\x07\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xdc\x93P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00!\x00	\x00000003_deployment_cancel.down.sqlUT\x05\x00\x01`m\xd2j\x00^\x00\xa1\xffdrop index idx_deployment_cancelled_at;\n\nalter table deployment\n    drop column cancelled_at;\n\x03\x00PK\x07\x08\x8bh\xa7\xa1e\x00\x00\x00^\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xdc\x93P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1f\x00	\x00000003_deployment_cancel.up.sqlUT\x05\x00\x01`m\xd2jT\xcdA\n\xc20\x14\x84\xe1}N1K=C\x0f\x13\x9ey\x03\x06^^J\x9db\xf5\xf4\x82\x1b\xebr\x18\xf8~\x0bq\x83\xec\x16\x84s\x8d\xf9\x1aL\x15\x000w\xb4\x19\xfbH4\xcb\xc6\x08z5A}\xf0!\x1b+\x9e]\xf7\xef\xc4{&\x91{\xc4RJ\xdbh\"z:\x0ft?\xea\xcf\xad\x7f\xd0\xccS\xf2r\xbe\xaeK\xf9\x0c\x00PK\x07\x08G\xac\xeb\xb1e\x00\x00\x00\x99\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x008\x94P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00 \x00	\x00000004_deployment_state.down.sqlUT\x05\x00\x01\x0dn\xd2j\x00o\x00\x90\xffdrop index idx_deployment_state;\n\nalter table deployment\n    drop column state,\n    drop column error_message;\n\x03\x00PK\x07\x08\x0e\xa9\x8cqv\x00\x00\x00o\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00k\xa2P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00000004_deployment_state.up.sqlUT\x05\x00\x01\xca\x86\xd2j\x84\x90AK\xfb@\x10\xc5\xef\xfb)\xde--\xb4\x7fJ\xaf\xe1\x7f\x90\xb6\x88X\xa2\x07\xc5c\x98\xeeN\x9a\xc5\xcdN\xd9\x9d\xd8\xf8\xed%-j\x10\xc19\xcf\xfb\xbd\x1f\x8f\x82r\x82\xd2!0\x1c\x9f\x82\xbcw\x1c\xd5\x00\x009\x07+\xa1\xef\"\xb2\x922\xde(\xd9\x96\xd2l\xbd\x9a#\x8a\"\xf6!\xc0qC}P\x14\x8f\xbbj{W\xdd\x16\x8b\x9faNIR\xddq\xcetd(\x0f\xfaK\xba(\x8dY.\xf1\xd42\xa4W+\x1dC\x1ah\xcb\xe0\xc1g\xf5\xf18\xd1\xcb\xf0\xf9\xc2x\x8dr\x8e\x0bP\x86\x95\xee\x14X\xd9\xd5\xa4\xf0ydQ\xc8\x82\xcc\x8aF\xd2\x854\x05hK\x8a\x86|`\x07I8sb\xd0\x81\xa2\x93\xc8\x0e\xb3\xcc\x8c\xd5x\xeb\xf9?\xd3\x9f\x1c\xe94~\xa1^7\xf9\x8f\xe2\xb9\xba\xaf\x1e^\xaa\xa2\xfc\xebqsSmv\xfb\xfdn[\xe0\xdc\x8e\x85\x96\xa2\xe5\x10>\xa5\xbff)\x8d\xb1\x89\xc7N\x1f\x1d\x0f\xf0n\xa8\xbf\xa1\xf5\xb5Y\xe2\xc4h\x96\x95\x94\xe7\xa5\xf9\x18\x00PK\x07\x08\xe3^\x88D	\x01\x00\x00\xd0\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00e\x94P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00'\x00	\x00000005_deployment_device_state.down.sqlUT\x05\x00\x01_n\xd2j\x00U\x00\xaa\xffalter table deployment_device\n    drop column state,\n    drop column failure_reason;\n\x03\x00PK\x07\x08\xad3\x07\xa2\\\x00\x00\x00U\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00k\xa2P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00%\x00	\x00000005_deployment_device_state.up.sqlUT\x05\x00\x01\xca\x86\xd2j\x8c\x90\xc1J3A\x10\x84\xef\xfb\x14u\xdb\xff\x87DD\xf0\x14<\x99 \xa2nr\x88x\\\xda\x9d\xde\xdd\xc1\xd9\x9e\xd0\xd3\x93\xe8\xdb\xcbH\"A<x\xee\xaf\x8b\xaf\x8a\x82\xb1\xc2\xe850\x1c\xefB\xfc\x98X\xacu\xbc\xf7\x1dW\x00@\xce\xa1\x8b!O\x82dd\x8c=i7\x92\xfe\xbb\xba\xfc\x0f\x89\x06\xc9!\xc0qO9\x18\xea\xcd\xaaY\xde7w\xf5\xec\xe7sO>d\xe5V\x99R\x94\xef\x94\xeb_S\xeaEU\xcd\xe7\xd8\x8e\xc5\xaa\xa8$\xc4\x1e62\xf8\xdd'\xf32\x9c\xd9&8\xef\xc0\xdeFVtq\xda\x056F\xd4\xc2{-91[\x17'\x86O_\xc6o\x12\x0f2\x03\xa5B\xa4\xf3\xe2	\xa4\x0c\x89\x08Q\x06Vh\x16\xf12\\Ty\xe7\xc8\xce\xc9\xe3DHl\xc7]nP?7\x0f\xcd\xfa\xa5\xa9\x17\x7f\xe4o\xd7O\x9b\xc7\xd5v\xb5\xacq\x18Y\x19\xbd\xd2\xd0\x96sN\xed\xa9\x89k\xc9N\xe6\x92CXT\x9f\x03\x00PK\x07\x08\xad\xb6\x900\xfa\x00\x00\x00\xb5\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xb1\x94P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00 \x00	\x00000006_deployment_retry.down.sqlUT\x05\x00\x01\xeen\xd2j\x00n\x00\x91\xffdrop index idx_deployment_parent_deployment_id;\n\nalter table deployment\n    drop column parent_deployment_id;\n\x03\x00PK\x07\x08\xefw9\xddu\x00\x00\x00n\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xb1\x94P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00000006_deployment_retry.up.sqlUT\x05\x00\x01\xeen\xd2jl\xcdA\n\xc30\x0cD\xd1\xbdO1\xcb\xf6\x0c9LP\xad)\x18\x149(2\xa4\xb7/\x04J\xb3\xf0\xfe\xf3\xbeX2\x90\xf22B\xb9[\xffl\xf4,\x00 \xaa\xa8\xdd\xc6\xe6\xd8%\xe8\xb9\xfe\x83\xb5)\xc6h\n\x1ff\x08\xbe\x19\xf4\xca\xe3f\xa0;\x94\xc6$\x0e\xe6\x15.\xa5\xd4\xa0$\xd1\\y\xa2\xe9y7\xa7\x97K\xf9m\x1f\xb3\xe4\xb9\x94\xef\x00PK\x07\x08\xa2\xd0\x02\x9bj\x00\x00\x00\xc6\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xd1\x94P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00'\x00	\x00000007_deployment_payload_hash.down.sqlUT\x05\x00\x01*o\xd2j\x00U\x00\xaa\xffalter table deployment\n    drop column payload_size,\n    drop column payload_sha256;\n\x03\x00PK\x07\x08V.C\x14\\\x00\x00\x00U\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xd5\x94P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00%\x00	\x00000007_deployment_payload_hash.up.sqlUT\x05\x00\x012o\xd2jt\xcd\xc1\xaa\x830\x10\x85\xe1}\x9e\xe2\xec4\x90\x85\\\xb8](>K\x19\xcdT\x851\x11\x9d,\xd2\xa7/4R\xea\xa2\xbb\x819?\x1f\x89\xf2\x0e\xa5A\x18\x9e7\x89y\xe5\xa0\x06\x00\xc8{\x8cQ\xd2\x1a\xb0Q\x96H\xfe~,O\xc6\x12\x94'\xde\x11\xa2\"$\x11x~P\x12E\xe3~v3\xfd\xfd\xdf0dez7\x9d1i\xf3\xa4\xdf(\x0e.\xf0E\xeb1F\x12>F\xae\x85\xc3\xa4s}\xbe\xadCc\xdd5(L\x8fr\xd4\x9f\xf2\x1c8TU\xdb\x0eY\x99\xac\xed\xcck\x00PK\x07\x08E\xdaS\xaa\x92\x00\x00\x00\xfc\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xf9\x94P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00#\x00	\x00000008_deployment_schedule.down.sqlUT\x05\x00\x01vo\xd2j|\xcdA\n\xc30\x0cD\xd1\xbdN\xa1{\xf80b\x1ai!\xb0\xe5\xe0LIz\xfbBV\x85\xd2\xae\x877\xdf\xd7\xdc5\xcb\xe3\xd2\xf4\xcb<\xf6>_#\x8a6\x90\xc5(\xd4\x16vf\xf9<?\xd7\xf4&\xb7%\x1e=\xf4\xafk\"\xbf3\x07\xb1h`\x13Ag\xac\xafCQU\xbd\xfd6\xfbs\x94\x1e\xc4\xa2\x81M\xde\x03\x00PK\x07\x085t\x96\xd1b\x00\x00\x00\xbd\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xf9\x94P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00!\x00	\x00000008_deployment_schedule.up.sqlUT\x05\x00\x01vo\xd2j\x8c\x90QN\xf40\x0c\x84\xdfs\x8ay\xdcJ\xff\x0d\xaa\xff,\x9176`\x918U\xe2\xaa[N\x8f\xd4\x02\xed\x02\x12\xfb\xe8\xe4\x9b\xb1g(\xbb48]\xb3\x80e\xcau-b\x1e\x00\x80\x98\x91j\x9e\x8b\xa1;5\x8f\xe4p-\xd2\x9d\xca\x84E\xfde\x1b\xf1VM`s\xcec\x08\xf3\xc4\xe4g't\xf1C\xfe\x1f\xa9	\xb9p$\x1fC\xd8\x07\xa8\xb1\xdc\xa0|\x8b\x87.~i\xaa\x9d\xec.\x9f\xcf\xc3!\xff~|,\xa4\xe6bdI\xe2\xa2\xc6u\xc1eK\xa4\x8c\xab>wiJ\x19S\xd3Bm\xc5\xab\xac\xff\xb6\xdfcKT\xc6<+\xc3\xaao\xc9\xd0\xe4I\x9aX\x92~\xc2\xf6\xd3\xb2\xb8 QO\xc4\xb2\x1b=\xd0\xd6\x87\xef\xce\x8b\xf1Ct\x18\xfe\xe8\xecg\xf0s\xa3\xca\xf7]\xfe\x82_\xee\xf0a\x0c\xef\x03\x00PK\x07\x08\x1a\xd6\xa4\x0c\xd7\x00\x00\x00\x1f\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00k\x95P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x18\x00	\x00000009_campaign.down.sqlUT\x05\x00\x01Kp\xd2jt\xcd\xd1	B1\x0c\x85\xe1\xf7Lq\x06p\x83\x0eSb\x13.\x816\x0d\xd7\xa8\xd7\xed\x05\x0b*\x82\xcf_r~\xd9g\xc0\\\xf4\x80\xc9QE\xa3\xcf\xc7P\xcf\xdax\x04\xdb\xe6\xd5\xa4\x10qO\xdd\x91|\xee\x8a\xcf\x11\x01\xc0k\xa2\xcd~\x1d\x8e\xaf\xa7\xd3\x7f\xbc\xf3M\x0b\xd1O\xfb\xcd\x97\xe4\xd4\xb2x\x15\x1b\x8f`\xdb\xbc\xd0s\x00PK\x07\x08\xc1\x04L\x0cd\x00\x00\x00\xb0\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00r\x95P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00000009_campaign.up.sqlUT\x05\x00\x01Yp\xd2j\x94\x91\xcdN\xc3@\x0c\x84\xefy\n\xdf\x9aJ=T q\xe9\x03 .= q^\x99\xb5\xdbX\xec\x9fv\xbd\xfd\xe1\xe9Q\x92\xa6A\xa5\x08qL<\x9f=\xb3c3\xa32(\xbe;\x06\x8b>\xa1\xec\x03\xb4\x0d\x00\x80\x10\xd4*\x04)\x8b\xc7|\x86\x0f>C\x88\n\xa1:\xb7\x1a\x14#M\x06\x15T<\x17E\x9f\xe0(\xda\x0d\x9f\xf0\x19\x03\xdf\x105\xd1?	L\xc9\x89E\x95\x18\x8c\x10\x1c0\xdb\x0es\xfb\xf8\xb4\xbc\x11\x96j-\x97b\xb4\xcb\\\xba\xe8\x08(\xd6>V\xcal\xa5H\x0c\xb7\x80\xf6\xd1\xa7\x85\x0f\xeby!\x10\xef\xb0:\x85\xc5\xeb\xdbv\xfb\xb2}^\x8c\x04\xe7\x1c\xb3\xf1\\\n\xee\x19\x94Oz\x07\xb9hm\xf4\xc9\xf1\x9fY\xabs\xcdr\xd34\x97\"$\x10\x9f@\xe8d\xa62\xcch3\x86k=\xed\xf0\xa7\x87\xd0)\xe7Ky\xc4\xc9\xc5\xb3\xe7\xa0\x83U$\x02\x1b]\xf53g\xa6B\xfb\xa3\x90y\xc7\x99\x83\xe5r\x15\xf4G\x88{\xd3`\xb1X$^\xfd\xba\xeb\x88\x07\x06	\xca{\xce?\x1fa}/\xd1\xecp\x0e'4\x1e\x9d&\xed\xb7\xc9r\xd3|\x0d\x00PK\x07\x08\xe044a#\x01\x00\x00\x9f\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xac\x96P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00)\x00	\x00000010_deployment_device_cleanup.down.sqlUT\x05\x00\x01\xa4r\xd2j\x00D\x00\xbb\xffalter table deployment\n    drop column device_cleanup_completed_at;\n\x03\x00PK\x07\x08	\xdfn\xafK\x00\x00\x00D\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xac\x96P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00'\x00	\x00000010_deployment_device_cleanup.up.sqlUT\x05\x00\x01\xa4r\xd2j\x00a\x00\x9e\xffalter table deployment\n    add column device_cleanup_completed_at timestamp with time zone null;\n\x03\x00PK\x07\x08\xfc\xa7\xd2\x8eh\x00\x00\x00a\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x06\x97P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1f\x00	\x00000011_package_version.down.sqlUT\x05\x00\x01Ms\xd2j\x94\xcc\xc1\x0d\xc20\x0c\x85\xe1{\xa6x\x03\xb0A\x87\xb1L\xf2\x84*\x9c88n%\xb6\xe7\x0e\\z\xff\xffO-\x19H\xbd\x1b\xd18\xcd\xdf\x9d#\xa5\xf1\xdc+\x0b\x00\xb4\xf0\x89\xeav\xf4\x81\xa9\xf5\xa9\x0f\xca\xc9X\xbb\x0f\xa9\xde\xa71\xd9Ds+\xe5?vI\xb9\xfd\xd4\xc1\xd7\xc1\x95\xf2u\xad\xad|\x06\x00PK\x07\x08\xe1\xfb\xd2L]\x00\x00\x00\xbc\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x06\x97P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x00	\x00000011_package_version.up.sqlUT\x05\x00\x01Ms\xd2j\xac\xce\xb1\xad\xc30\x10\x03\xd0\xdeSp\x80\xbf\xc1\x1fF8K\xf4\xffBNw\x8aur\x90L\x1f\xc0\xa5\x912%\x0b>R4\xb8#dU\xa2\xb0\xab?\x1b-\x16\x00\x90R\x90]g3\xec\xbcO\x8eH]\xf2M\xfe\x98\x0e\xee\xa3\xba\x0d\xac\xeeJ1\x98\x07l\xaa\xa2p\x93\xa9\x81Mt\xf0\xe7\xea\\\xfa){\xeb\xca`I\x12\x88\xda8BZ\xc7\xa3\xc6\xff\x19\xf1r\xe3	\xff.\xcb\xe7\xab\xa9\xf0\xa8\x99\xdf\\z\x0f\x00PK\x07\x08\x0cv\xaa\x8c\x7f\x00\x00\x00\x14\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00p\x97P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1f\x00	\x00000012_mc_group_status.down.sqlUT\x05\x00\x01\x15t\xd2j\x94\xce\xc1	\x031\x0cD\xd1\xbb\xabP\x01\xe9`\x8b\x11\x8a5\x84\x05ye\xbc\xe3@\xba\x0f\x04\x92K\xf0a\xef\x7f\x1e\xe3#\xbb\xd0\xee\x01q\xf4\xc8W\xc3Au<\xf7\nmU\x1f#g\xdfJ\xb1 \xc6*,\"\"\x1f\xa9f\xccv\xc8w\xa8'\x8d\xf3\xd4\x9a\xad\x07\x08W\xe3\x12\xbb\xa4\xdc\xfej\x9b\xcc\xdfc\xdd}+\xef\x01\x00PK\x07\x08\xea)\x94\xbfd\x00\x00\x00\xdc\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00p\x97P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x00	\x00000012_mc_group_status.up.sqlUT\x05\x00\x01\x15t\xd2j\xac\x91An\xeb0\x0cD\xf7>\xc5,\x13\xc07\xc8a\x04F\x1a\xe7\x0b\x9f\x92\x0c\x89J\xe1\x9e\xbe\x88\xd3\xb8m\x92\xb6\x9b.\x05\xf1q\x1e\x86\xa2\xc6\n\x93\xa3\x12\x81\xb3\x96%1\xdb\x00\x00\x12\x02|\xd1\x9e2\xa4[q\xc9\xbbS-}v1\xe0X\x8aR2r1\xe4\xae\x8a\xc0I\xba\x1a&\xd1\xc6\xf1\x9e\xdf\xd0fb\xbd9_\xd2\xac4\x06'\x06\x8b\x89\xcd$\xcdx\x89\xf6o}\xe2\xb5d\xae\x8b\x0f\xc3\xf0\\\xd1\x05\x9e\xa3\xe7\x9f&\xf9J1~\x17\xb5\x15\x80\xdd\x9a\xfaQ\xd7\xa5\x91\xdec\xd8\xea\x18\xdf\x07\xce\x8e=\xe2\xb8\x18\xe5\xee\xef\xb6\xeb\x82\xb6$\xaa1\xdb\xe3\x88\x84P\x9f\xe2W\xd3_\xea\xdb\x88\xd5f\xae1I]\xf0\x9f\x0bv_\xdc\xc7\x9b\xe9\xf8Yk\x7fM\x9aJe<\xe5\x9f\xb0=*'Vf\xcf\xf6x!\x94\x8c\xc0\xcb\x0d\xe0\xa5y	\x1c\xf6\x87\xe1m\x00PK\x07\x08H\xf3\x82!\xe9\x00\x00\x00x\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00A\x98P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1f\x00	\x00000013_fragment_repair.down.sqlUT\x05\x00\x01\xabt\xd2jt\xcb\xc1	\x020\x0c\x05\xd0{\xa7\xf8\x03\xb8\x81\xc3\x94\xd8~EH\x9b\x10~\x0fn/\x887\xf1\xfe\x9e\xb9X\x90\xdd\x9c\x98L\x8f\xd7\xe2V\x03\x80Y\x91\x18\xe1gm\xdc\xcb\x1e\xbd\x98\xf6\xac>b\xa5S\x9c\xddt\xf9\x91_\xf4	#\xce\xfeOL\xe2J\xf5\x11g\xeb\xda\xde\x03\x00PK\x07\x08(\xe0\xbe*U\x00\x00\x00\x8a\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00A\x98P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x00	\x00000013_fragment_repair.up.sqlUT\x05\x00\x01\xabt\xd2j\x94\xce1\xae\xc20\x10\x84\xe1>\xa7\x98\x03\xbc\xe2\xf5\x1c&Z\xe2I\xb0\xb4\xde\xb5\xccX\x08N\x8f\x14\xd1RP\xfe\xc5'\xfd\xe6\xe2\x80\xec\xeaDa\xf7|6\x86\x16\x00\xb0R\xb0\xa5\xcf\x16\x18\xecV\xc7j\x12[\xd7\xba\xe5\x0c\xa1\x86xp R\x88\xe9\x8e\xc2\xdd\xa6\x0b\xff\x7f_\xfc>\xec\xf8\x1d\x9f\xeas\xb0e\xebN\xb1\xac&\xa86\xdee\xad\xe3Qu;\x13\xaf\x0c\"\xa6\xfbey\x0f\x00PK\x07\x08\x98>\x1e\xe5v\x00\x00\x00\xdb\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xd5\x98P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00 \x00	\x00000014_fragmentation_v2.down.sqlUT\x05\x00\x01\xc3u\xd2jl\xcd\xc1\xad\xc4 \x0c\x84\xe1;U\xb8\x80\xd7A\x8a\xb1\x1c3/B!62^\xa4\xed~\x95;\xe7\x99O\xbf\xf4DP\xca\xd9A\x15\xa3\xfb\xf7\x81%W\xac\xa6(DD5|\x90z\xff<F\xff!\x17WI\xe1\xb3\xbb\xde\x1cP\xb4\x85\xca\x92\x7f\xfb\xf3\xc4\x9c\xcd\x8d\xd5\xf2(e_\xdb\xcaw\x90|\xe9\x10\xbd\xe5\x02/\xc4lnG\xf9\x0d\x00PK\x07\x08\x8b\x85\x16\x95h\x00\x00\x00\xb4\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xd5\x98P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00000014_fragmentation_v2.up.sqlUT\x05\x00\x01\xc3u\xd2jl\x8dAN\x041\x0c\x04\xef\xf3\x8a~\x00\x078\xefc,o\xd2;X\xeb8\xa3\xc4\x19\x04\xafG\xcb\x959vKU\xa5\x9e\x1cH\xbd;Qyx\xffn\x8c\xdc\x00@kE\xe9\xbeZ\xe01t\x7f\xfd\x9a\xd6C\x0e-O\xdd)'\xc7\xb4\x1e\x98M\xdd-\x12\xd1\x13\xb1\xdcQ\xf9\xd0\xe5\x89\x8f\xdb\xb6]\x17\xa4\xf2\xb4\xc2\xab\x90L\xce\x97WJ$,\x92;\xc7\x7f\xf5\xfb\xdb%[5U\xee\xde\xcbS\x06\x0b\xedd\x15M\xa45\xce\xd4v\xe0\xcb\xf2\xf3o\xe2\xa7\x07\x11\xcb\xfd\xb6\xfd\x0e\x00PK\x07\x08\xb3~\x80\x11\x92\x00\x00\x00\x06\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00R\x99P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\"\x00	\x00000015_multicast_setup_v2.down.sqlUT\x05\x00\x01\xacv\xd2j\x00H\x00\xb7\xffalter table deployment\n    drop column multicast_setup_package_version;\n\x03\x00PK\x07\x08\xfa\xd4f2O\x00\x00\x00H\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00R\x99P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00 \x00	\x00000015_multicast_setup_v2.up.sqlUT\x05\x00\x01\xacv\xd2j\x00c\x00\x9c\xffalter table deployment\n    add column multicast_setup_package_version smallint not null default 1;\n\x03\x00PK\x07\x08\xb1< Qj\x00\x00\x00c\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xb7\x99P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00#\x00	\x00000016_firmware_management.down.sqlUT\x05\x00\x01jw\xd2j\xac\xd0An\x850\x0c\x04\xd0}N\xe1\x03\xf4\x06\x1c&2dB#%v\xe4\x1aho\xdf\x15U%h\xe8\xe2\xef_2\xe3\xe1\xea0r\x9e+(\xa1W\xfdj\x10\x8f	{Y\x10\x88\x88\x92i\xa7E\xeb\xd6\x84\xf2\x11w\xd8GQ\x89\x8b\xb6^\xe1H\x91\xfd\xed\x02\x0d\xb3\xaa?\xa0\xad\xaf\xc6	\xb14^\xf1`\x7f%sv\xd8P\x08>\xc7_\xcc\xc8j\xb8\x92\xf7\x1f2\x85p?\xcd\xa8\xd9\xf8\x84\x97o\x92\xb0\x9fu\xff\x1b\xbd\x89'=\xe4\xcfrw\x8fs\xb1v\xb0\xe1\xcc\x1a\x88\xc6\xc2+\x1a\xc4\xa7\xf0=\x00PK\x07\x08\xde*X\x17\x9a\x00\x00\x00Z\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xb7\x99P]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00!\x00	\x00000016_firmware_management.up.sqlUT\x05\x00\x01jw\xd2j\xd4\x92\xc1N\xc40\x0cD\xef\xfd\n\x7f\x00\x07\xee|L\xe44\x93n$\xc7\xaeR\xa7\x05\xbe\x1e\xedJ\xf4\xc0\xb2\xb0[N\x1c#\xcdx\x9e\xe3aq4r\x8e\x02J\x98\xc5\xde*\xd4\x07\"\"N\x89F\x93^\x95riu\xe3\x86PYy\xc2YB\xd1L\xc0JjN\xdaE(!s\x17\xa7\xcc\xb2\xe0\xe9\xe6\x88\x15m)\xa6\x14\xcbT\xd4\xaf\xed\xcfW\xd6\x86h\xe6\x81\x9d\xbcT,\xceu\xa6\xad\xf8\xe9\xf2\xa4wS\\\x00n\xf9F\xeb\xea\xc9\xb6G\"\x13\xd6O\xd00Z\x9d\x05\x8e\xf48A\x9f\xa7\xc6	\xa1T\x9e\xf0\x97A\xfb*\x87Q\xf2v`\xa1\x97a\xf8\xbe !a-#\xbe\x86\x9c\xf6\x90\xfd\xaf\x7fa\x89\xc8\xd6p\xafZ\xf1\xea\xf7j9\x9f\x9b\xfd\x93\xf8\xff_\xe7c\x00PK\x07\x08\xe6\xa0\xb1\xcb\xcd\x00\x00\x00\xbe\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00W\x9aP]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x18\x00	\x00000017_firmware.down.sqlUT\x05\x00\x01\x96x\xd2j\\\xcc]\n\xc20\x10\xc4\xf1\xf7\x9cb\xee\x91\xc3\x84\xd5\x9d\xe2B>\xca\x18m\xbd\xbd\xd0\x82\xa2\xcf\xf3\x9f\x9fk\xac\x88\xee\xdc\x11\xbe\x17\xe7Z\xc7\xab\xb1\xcf\xb2\x84\xdafb	\xcf)Y\x9d\x14\xa6]*\xf1\x8d\x12\x00\x1c\xc4u\xd4G\xeb\xf8=\xfd\xe1\x9f\xf1f\xf2\x83~R\xf7\x18=\x9f\xe5\xa9/\xa1\xb6\x99\x98\xd3{\x00PK\x07\x08\x96\xac\x10s`\x00\x00\x00\x9c\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00W\x9aP]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00000017_firmware.up.sqlUT\x05\x00\x01\x96x\xd2j\x94Q\xcbn\xc20\x10\xbc\xe7+\xf6\x18$\x0e\xb4R{\xe1c\xa2%;4V\xfdH7k |}\x95\x04\x93\n\xd1J=Z\xf3\xf0\xecL\xab`\x03\x19\x1f<\xe8\xe84\x9cYAuED\xe4\x84rvB\xbd\xba\xc0:\xd2'F\x8a\xc9(f\xef\xb73cQK\xc3F\xe6\x02\x06\xe3\xd0\xd3\xd9Y7?\xe9\x9a\"\x1e\x14\xb9\x97\x7f*N\xd0\xc1\xa5H'\xd6\xb6c\xad_v\xbb\xcd\x03\xa5c\x959\xf7\x1f\x9c\x9eG\x9fX\xe80\x1a\xf89\xd6\x0c\xee\nr\xd1\xf0\x01\xfd\x8d\xd2\xf1\xeb\xdb\xfbS\x97\x00cac\xea\x06K\x8aj\xb3\xaf\xaa[\xbd9\xba\xaf<Y\x0b.\xe4\xe4\xd2\x94\xa6\x9b\x12\xbd)g\xa6x\x9f\xa1.\xe0\xb6\x940Y\xb27\xe8m0A\xef\xd3\x18\x10m\xae\x8aE\xa8M>\x87\xd5\xa3)#N\xa3\x91\xe2\x08El1\xdc	\xd3\x87\x02\x0f\x03\x0dX\xceYs\xaf\x81\xd7\x9f\xd6\xecN\x16qA\xea\x1f\xc8f_}\x0f\x00PK\x07\x08\xfc\xf1\xfa\x07\xfa\x00\x00\x00[\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00*\x9bP]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1b\x00	\x00000018_compression.down.sqlUT\x05\x00\x01 z\xd2j\x00]\x00\xa2\xffalter table deployment\n    drop column compressed_payload_size,\n    drop column compression;\n\x03\x00PK\x07\x08Q\xbd\x87/d\x00\x00\x00]\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00*\x9bP]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x19\x00	\x00000018_compression.up.sqlUT\x05\x00\x01 z\xd2jt\xce1\xaa\xc2@\x10\x06\xe0~O\xf1wy\x0f,\x82m\xb0\x12\x0b\x0b\x131\x07\x08cf\xd4\xc0dw\xd9\x9d\x15\xe2\xe9m\x03\x92\x0b||\xa4&	Fw\x15\xb0D\x0d\xcb,\xde\x1c\x00\x103\xc6\xa0e\xf6\x18\xc3\x1c\x93\xe4<\x05\x8f7\xa5\xf1E\xe9o_\xff\xc3\x07\x83/\xaa`yPQC\xd5v\xc3\xb1\xbb\\o\xa7\xbe?wm\xb5\xdb\x92\x84\x87H\x8b\x06\xe2!O\x1f\xc1\xe4M\x9e\x92~\xc5\xbaq\xaeD&[\xff\x90\xc56\xa9\x03\xd6r\xe3\xbe\x03\x00PK\x07\x08\x9d\x1b\xed:\x8a\x00\x00\x00\xe3\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00k\x9bP]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00000019_signing.down.sqlUT\x05\x00\x01\x9az\xd2j\x00h\x00\x97\xffalter table deployment\n    drop column payload_signed;\n\nalter table firmware\n    drop column signature;\n\x03\x00PK\x07\x08\xb9\x9e\xd0\xcao\x00\x00\x00h\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00k\x9bP]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x15\x00	\x00000019_signing.up.sqlUT\x05\x00\x01\x9az\xd2j\\\xcc\xd1\xa9\xc30\x0c\x05\xd0\x7fOq\xf7\xc80\x8f\x9b\xa7\x9b\x12\x90\xa5\xe0\xc8\x14o_\xda\xaf\xd2\x01\xce\xa1\x97\x06\x8a\xbb\x0b\xc79\xfa\x93C\x0d\x00h\x86\xff\xf4\xd9\x03\xf7\xf9\x08\xd6\x1c\xc2\xbeJDL\xf7\xad\xb5oj\xba<WW\xd4/\xbe\xb8<i\x7f\xefD\x86=\xd3\xc5@d}\x1e\x98\x0eN/\x1c\xf4[[{\x0d\x00PK\x07\x08\xbf\xb59\xf8b\x00\x00\x00\x90\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe7\x9bP]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00000020_chunks.down.sqlUT\x05\x00\x01\x82{\xd2j\x84\x8c\xcd\x0d\xc20\x0c\x85\xef\x9e\xe2\x0d\xc0\x06\x1d&*\xf6\x93\xa8p\xec\xa8u\x90`z\x16\x08\xe2\xfc\xfd\xec^<Q\xfb\xdd	\xe3\xf0|wF5\xe3\xebP\n\x00\xd8\x99\x03\x9a>{@\x1f3\x9eW\xd3\xec\xc3Y\xb4Md=\xf8[\xde\xd6F\xd3\x9cQ\xbf\xe0u|\xb8\xc9w\x00PK\x07\x08\x7f\x95\xc8\xb5U\x00\x00\x00\xb3\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe7\x9bP]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00000020_chunks.up.sqlUT\x05\x00\x01\x82{\xd2j\xa4\xcf\xc1\x0d\x021\x0cD\xd1\xfbV1\x05p\x803\xc5D!\x1e \xc2\xb1W\xbb6\x12TO\x03\x81\x0b\x05\xbc\x19\xfd\xaa\xc1\x0dQ/J\x08W\xf5\xd7\xa0\xc5\x02\x00U\x04\xcd5\x87\xa1\xdd\xd3\x1ee\xefo\xa2[\xf0\xc6\x0d\xe6\x01KU\x08\xaf55p<\xccU\xf3\xb4\xf8\xceNs\xb6\x97\xe6cU\x06\xe5\xc7\xe5yY\xe6\x01E\xf8\xec\x8d\x7fM\x7f\x06\x00PK\x07\x08\xfe\xdb\x85\x01k\x00\x00\x00\x1b\x01\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xaepI\\\xc2\x9e\xaa]I\x00\x00\x00\xa5\x00\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x00\x00\x00\x00000001_initial.down.sqlUT\x05\x00\x01\xa9\xe9\x89iPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xaepI\\\x8fM!\xdb2\x01\x00\x00\xa4\x05\x00\x00\x15\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x97\x00\x00\x00000001_initial.up.sqlUT\x05\x00\x01\xa9\xe9\x89iPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00p\x93P]\xe1\x9b7k\x1a\x01\x00\x00\xff\x03\x00\x00!\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x15\x02\x00\x00000002_deployment_resume.down.sqlUT\x05\x00\x01\x95l\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00p\x93P]\x007\xe2\xe2\xe5\x01\x00\x00\xcc\x07\x00\x00\x1f\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x87\x03\x00\x00000002_deployment_resume.up.sqlUT\x05\x00\x01\x95l\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xdc\x93P]\x8bh\xa7\xa1e\x00\x00\x00^\x00\x00\x00!\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xc2\x05\x00\x00000003_deployment_cancel.down.sqlUT\x05\x00\x01`m\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xdc\x93P]G\xac\xeb\xb1e\x00\x00\x00\x99\x00\x00\x00\x1f\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x7f\x06\x00\x00000003_deployment_cancel.up.sqlUT\x05\x00\x01`m\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x008\x94P]\x0e\xa9\x8cqv\x00\x00\x00o\x00\x00\x00 \x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81:\x07\x00\x00000004_deployment_state.down.sqlUT\x05\x00\x01\x0dn\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00k\xa2P]\xe3^\x88D	\x01\x00\x00\xd0\x01\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x07\x08\x00\x00000004_deployment_state.up.sqlUT\x05\x00\x01\xca\x86\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00e\x94P]\xad3\x07\xa2\\\x00\x00\x00U\x00\x00\x00'\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81e	\x00\x00000005_deployment_device_state.down.sqlUT\x05\x00\x01_n\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00k\xa2P]\xad\xb6\x900\xfa\x00\x00\x00\xb5\x01\x00\x00%\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x1f\n\x00\x00000005_deployment_device_state.up.sqlUT\x05\x00\x01\xca\x86\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xb1\x94P]\xefw9\xddu\x00\x00\x00n\x00\x00\x00 \x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81u\x0b\x00\x00000006_deployment_retry.down.sqlUT\x05\x00\x01\xeen\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xb1\x94P]\xa2\xd0\x02\x9bj\x00\x00\x00\xc6\x00\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81A\x0c\x00\x00000006_deployment_retry.up.sqlUT\x05\x00\x01\xeen\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xd1\x94P]V.C\x14\\\x00\x00\x00U\x00\x00\x00'\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x00\x0d\x00\x00000007_deployment_payload_hash.down.sqlUT\x05\x00\x01*o\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xd5\x94P]E\xdaS\xaa\x92\x00\x00\x00\xfc\x00\x00\x00%\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xba\x0d\x00\x00000007_deployment_payload_hash.up.sqlUT\x05\x00\x012o\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xf9\x94P]5t\x96\xd1b\x00\x00\x00\xbd\x00\x00\x00#\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xa8\x0e\x00\x00000008_deployment_schedule.down.sqlUT\x05\x00\x01vo\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xf9\x94P]\x1a\xd6\xa4\x0c\xd7\x00\x00\x00\x1f\x02\x00\x00!\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81d\x0f\x00\x00000008_deployment_schedule.up.sqlUT\x05\x00\x01vo\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00k\x95P]\xc1\x04L\x0cd\x00\x00\x00\xb0\x00\x00\x00\x18\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x93\x10\x00\x00000009_campaign.down.sqlUT\x05\x00\x01Kp\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00r\x95P]\xe044a#\x01\x00\x00\x9f\x02\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81F\x11\x00\x00000009_campaign.up.sqlUT\x05\x00\x01Yp\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xac\x96P]	\xdfn\xafK\x00\x00\x00D\x00\x00\x00)\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xb6\x12\x00\x00000010_deployment_device_cleanup.down.sqlUT\x05\x00\x01\xa4r\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xac\x96P]\xfc\xa7\xd2\x8eh\x00\x00\x00a\x00\x00\x00'\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81a\x13\x00\x00000010_deployment_device_cleanup.up.sqlUT\x05\x00\x01\xa4r\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x06\x97P]\xe1\xfb\xd2L]\x00\x00\x00\xbc\x00\x00\x00\x1f\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81'\x14\x00\x00000011_package_version.down.sqlUT\x05\x00\x01Ms\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x06\x97P]\x0cv\xaa\x8c\x7f\x00\x00\x00\x14\x01\x00\x00\x1d\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xda\x14\x00\x00000011_package_version.up.sqlUT\x05\x00\x01Ms\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00p\x97P]\xea)\x94\xbfd\x00\x00\x00\xdc\x00\x00\x00\x1f\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xad\x15\x00\x00000012_mc_group_status.down.sqlUT\x05\x00\x01\x15t\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00p\x97P]H\xf3\x82!\xe9\x00\x00\x00x\x02\x00\x00\x1d\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81g\x16\x00\x00000012_mc_group_status.up.sqlUT\x05\x00\x01\x15t\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00A\x98P](\xe0\xbe*U\x00\x00\x00\x8a\x00\x00\x00\x1f\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xa4\x17\x00\x00000013_fragment_repair.down.sqlUT\x05\x00\x01\xabt\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00A\x98P]\x98>\x1e\xe5v\x00\x00\x00\xdb\x00\x00\x00\x1d\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81O\x18\x00\x00000013_fragment_repair.up.sqlUT\x05\x00\x01\xabt\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xd5\x98P]\x8b\x85\x16\x95h\x00\x00\x00\xb4\x00\x00\x00 \x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x19\x19\x00\x00000014_fragmentation_v2.down.sqlUT\x05\x00\x01\xc3u\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xd5\x98P]\xb3~\x80\x11\x92\x00\x00\x00\x06\x01\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xd8\x19\x00\x00000014_fragmentation_v2.up.sqlUT\x05\x00\x01\xc3u\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00R\x99P]\xfa\xd4f2O\x00\x00\x00H\x00\x00\x00\"\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xbf\x1a\x00\x00000015_multicast_setup_v2.down.sqlUT\x05\x00\x01\xacv\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00R\x99P]\xb1< Qj\x00\x00\x00c\x00\x00\x00 \x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81g\x1b\x00\x00000015_multicast_setup_v2.up.sqlUT\x05\x00\x01\xacv\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xb7\x99P]\xde*X\x17\x9a\x00\x00\x00Z\x02\x00\x00#\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81(\x1c\x00\x00000016_firmware_management.down.sqlUT\x05\x00\x01jw\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xb7\x99P]\xe6\xa0\xb1\xcb\xcd\x00\x00\x00\xbe\x03\x00\x00!\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x1c\x1d\x00\x00000016_firmware_management.up.sqlUT\x05\x00\x01jw\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00W\x9aP]\x96\xac\x10s`\x00\x00\x00\x9c\x00\x00\x00\x18\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81A\x1e\x00\x00000017_firmware.down.sqlUT\x05\x00\x01\x96x\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00W\x9aP]\xfc\xf1\xfa\x07\xfa\x00\x00\x00[\x02\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xf0\x1e\x00\x00000017_firmware.up.sqlUT\x05\x00\x01\x96x\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00*\x9bP]Q\xbd\x87/d\x00\x00\x00]\x00\x00\x00\x1b\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x817 \x00\x00000018_compression.down.sqlUT\x05\x00\x01 z\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00*\x9bP]\x9d\x1b\xed:\x8a\x00\x00\x00\xe3\x00\x00\x00\x19\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xed \x00\x00000018_compression.up.sqlUT\x05\x00\x01 z\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00k\x9bP]\xb9\x9e\xd0\xcao\x00\x00\x00h\x00\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xc7!\x00\x00000019_signing.down.sqlUT\x05\x00\x01\x9az\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00k\x9bP]\xbf\xb59\xf8b\x00\x00\x00\x90\x00\x00\x00\x15\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x84\"\x00\x00000019_signing.up.sqlUT\x05\x00\x01\x9az\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe7\x9bP]\x7f\x95\xc8\xb5U\x00\x00\x00\xb3\x00\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x812#\x00\x00000020_chunks.down.sqlUT\x05\x00\x01\x82{\xd2jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe7\x9bP]\xfe\xdb\x85\x01k\x00\x00\x00\x1b\x01\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xd4#\x00\x00000020_chunks.up.sqlUT\x05\x00\x01\x82{\xd2jPK\x05\x06\x00\x00\x00\x00(\x00(\x00J\x0d\x00\x00\x8a$\x00\x00\x00\x00"
 		fs.Register(data)
 	}
 	
\ No newline at end of file
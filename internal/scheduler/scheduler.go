@@ -0,0 +1,74 @@
+// Package scheduler starts the pending deployments once their start time has
+// passed. As the schedule is stored in the database, scheduled deployments
+// survive a restart of the FUOTA server.
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/config"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/fuota"
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/storage"
+)
+
+// Setup starts the scheduler loop. The loop stops when the given context is
+// cancelled.
+func Setup(ctx context.Context, c *config.Config) error {
+	interval := c.FUOTAServer.Scheduler.Interval
+
+	log.WithFields(log.Fields{
+		"interval": interval,
+	}).Info("scheduler: starting deployment scheduler")
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if err := startDueDeployments(ctx); err != nil && ctx.Err() == nil {
+				log.WithError(err).Error("scheduler: start due deployments error")
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				log.Info("scheduler: stopping deployment scheduler")
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// startDueDeployments starts the pending deployments of which the start time
+// has passed.
+func startDueDeployments(ctx context.Context) error {
+	ids, err := storage.GetDueDeploymentIDs(ctx, storage.DB(), time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		// the deployment has already been started, but its state has not
+		// yet been updated
+		if fuota.IsRunning(id) {
+			continue
+		}
+
+		d, err := fuota.LoadDeployment(ctx, id)
+		if err != nil {
+			log.WithError(err).WithField("deployment_id", id).Error("scheduler: load deployment error")
+			continue
+		}
+
+		log.WithField("deployment_id", id).Info("scheduler: starting scheduled deployment")
+
+		fuota.Start(d)
+	}
+
+	return nil
+}
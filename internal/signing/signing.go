@@ -0,0 +1,292 @@
+// Package signing wraps payloads in a signed envelope, so that devices can
+// verify the authenticity of the reassembled payload, and verifies the
+// signatures of uploaded firmware against the trusted public keys.
+//
+// The envelope has the following format:
+//
+//	Version (1 byte) | Algorithm (1 byte) | Payload length (4 bytes, LE) | Payload | Signature (64 bytes)
+//
+// The signature is the ECDSA P-256 signature (r | s, both 32 bytes big
+// endian) over the SHA-256 digest of the preceding bytes.
+package signing
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/config"
+)
+
+const (
+	// EnvelopeVersion defines the version of the envelope format.
+	EnvelopeVersion = 0x01
+
+	// AlgorithmECDSAP256SHA256 defines the ECDSA P-256 / SHA-256 signature
+	// algorithm.
+	AlgorithmECDSAP256SHA256 = 0x01
+
+	headerSize    = 6
+	signatureSize = 64
+)
+
+var (
+	// ErrNoSigner is returned when no signing key has been configured.
+	ErrNoSigner = errors.New("no signing key configured")
+
+	// ErrNoTrustedKeys is returned when no trusted public keys have been
+	// configured.
+	ErrNoTrustedKeys = errors.New("no trusted keys configured")
+
+	// ErrInvalidSignature is returned when the signature could not be
+	// verified.
+	ErrInvalidSignature = errors.New("invalid signature")
+
+	// ErrInvalidEnvelope is returned when the envelope could not be decoded.
+	ErrInvalidEnvelope = errors.New("invalid envelope")
+)
+
+var (
+	mu          sync.RWMutex
+	signer      crypto.Signer
+	trustedKeys []*ecdsa.PublicKey
+)
+
+// Setup loads the signing key and the trusted public keys from the
+// configured files.
+func Setup(c *config.Config) error {
+	conf := c.FUOTAServer.Signing
+
+	if conf.KeyFile != "" {
+		key, err := loadPrivateKey(conf.KeyFile)
+		if err != nil {
+			return fmt.Errorf("load signing key error: %w", err)
+		}
+
+		if err := SetSigner(key); err != nil {
+			return err
+		}
+
+		log.WithField("key_file", conf.KeyFile).Info("signing: signing key loaded")
+	}
+
+	var keys []*ecdsa.PublicKey
+	for _, f := range conf.TrustedKeyFiles {
+		key, err := loadPublicKey(f)
+		if err != nil {
+			return fmt.Errorf("load trusted key error: %w", err)
+		}
+
+		keys = append(keys, key)
+
+		log.WithField("key_file", f).Info("signing: trusted key loaded")
+	}
+	SetTrustedKeys(keys)
+
+	return nil
+}
+
+// SetSigner sets the signer used for signing the envelopes. This makes it
+// possible to use a key provider other than a key file (e.g. PKCS#11), as
+// long as it implements the crypto.Signer interface. The public key must be
+// an ECDSA P-256 key.
+func SetSigner(s crypto.Signer) error {
+	if _, err := p256PublicKey(s.Public()); err != nil {
+		return err
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	signer = s
+
+	return nil
+}
+
+// SetTrustedKeys sets the public keys that are trusted for signing firmware.
+func SetTrustedKeys(keys []*ecdsa.PublicKey) {
+	mu.Lock()
+	defer mu.Unlock()
+	trustedKeys = keys
+}
+
+// HasTrustedKeys returns true when trusted public keys have been configured.
+func HasTrustedKeys() bool {
+	mu.RLock()
+	defer mu.RUnlock()
+	return len(trustedKeys) != 0
+}
+
+// Wrap wraps the given payload in a signed envelope.
+func Wrap(payload []byte) ([]byte, error) {
+	mu.RLock()
+	s := signer
+	mu.RUnlock()
+
+	if s == nil {
+		return nil, ErrNoSigner
+	}
+
+	b := make([]byte, headerSize, headerSize+len(payload)+signatureSize)
+	b[0] = EnvelopeVersion
+	b[1] = AlgorithmECDSAP256SHA256
+	binary.LittleEndian.PutUint32(b[2:], uint32(len(payload)))
+	b = append(b, payload...)
+
+	digest := sha256.Sum256(b)
+	der, err := s.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return nil, fmt.Errorf("sign error: %w", err)
+	}
+
+	sig, err := rawSignature(der)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(b, sig...), nil
+}
+
+// Unwrap verifies the given envelope using the given public key and returns
+// the payload.
+func Unwrap(envelope []byte, pub *ecdsa.PublicKey) ([]byte, error) {
+	if len(envelope) < headerSize+signatureSize || envelope[0] != EnvelopeVersion || envelope[1] != AlgorithmECDSAP256SHA256 {
+		return nil, ErrInvalidEnvelope
+	}
+
+	size := int(binary.LittleEndian.Uint32(envelope[2:]))
+	if len(envelope) != headerSize+size+signatureSize {
+		return nil, ErrInvalidEnvelope
+	}
+
+	digest := sha256.Sum256(envelope[:headerSize+size])
+	if !verify(pub, digest[:], envelope[headerSize+size:]) {
+		return nil, ErrInvalidSignature
+	}
+
+	return envelope[headerSize : headerSize+size], nil
+}
+
+// Verify verifies the given signature of the payload against the trusted
+// public keys. The signature must be an ECDSA signature over the SHA-256
+// digest of the payload, either ASN.1 DER encoded or as r | s (64 bytes).
+func Verify(payload, signature []byte) error {
+	mu.RLock()
+	keys := trustedKeys
+	mu.RUnlock()
+
+	if len(keys) == 0 {
+		return ErrNoTrustedKeys
+	}
+
+	digest := sha256.Sum256(payload)
+	for _, key := range keys {
+		if verify(key, digest[:], signature) {
+			return nil
+		}
+	}
+
+	return ErrInvalidSignature
+}
+
+func verify(pub *ecdsa.PublicKey, digest, signature []byte) bool {
+	if len(signature) == signatureSize {
+		r := new(big.Int).SetBytes(signature[:32])
+		s := new(big.Int).SetBytes(signature[32:])
+		return ecdsa.Verify(pub, digest, r, s)
+	}
+
+	return ecdsa.VerifyASN1(pub, digest, signature)
+}
+
+// rawSignature converts the given ASN.1 DER encoded ECDSA signature into
+// r | s.
+func rawSignature(der []byte) ([]byte, error) {
+	var sig struct {
+		R, S *big.Int
+	}
+	if _, err := asn1.Unmarshal(der, &sig); err != nil {
+		return nil, fmt.Errorf("unmarshal signature error: %w", err)
+	}
+
+	b := make([]byte, signatureSize)
+	sig.R.FillBytes(b[:32])
+	sig.S.FillBytes(b[32:])
+
+	return b, nil
+}
+
+func p256PublicKey(pub crypto.PublicKey) (*ecdsa.PublicKey, error) {
+	key, ok := pub.(*ecdsa.PublicKey)
+	if !ok || key.Curve != elliptic.P256() {
+		return nil, errors.New("key must be an ECDSA P-256 key")
+	}
+
+	return key, nil
+}
+
+// loadPrivateKey loads the PEM encoded (SEC 1 or PKCS #8) private key from
+// the given file.
+func loadPrivateKey(path string) (crypto.Signer, error) {
+	b, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if key, err := x509.ParseECPrivateKey(b); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(b)
+	if err != nil {
+		return nil, fmt.Errorf("parse private key error: %w", err)
+	}
+
+	s, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("key must be an ECDSA P-256 key")
+	}
+
+	return s, nil
+}
+
+// loadPublicKey loads the PEM encoded (PKIX) public key from the given file.
+func loadPublicKey(path string) (*ecdsa.PublicKey, error) {
+	b, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := x509.ParsePKIXPublicKey(b)
+	if err != nil {
+		return nil, fmt.Errorf("parse public key error: %w", err)
+	}
+
+	return p256PublicKey(key)
+}
+
+func readPEM(path string) ([]byte, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read file error: %w", err)
+	}
+
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found in %s", path)
+	}
+
+	return block.Bytes, nil
+}
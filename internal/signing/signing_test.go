@@ -0,0 +1,138 @@
+package signing
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/chirpstack/chirpstack-fuota-server/v4/internal/config"
+)
+
+func TestSetup(t *testing.T) {
+	assert := require.New(t)
+	dir := t.TempDir()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(err)
+
+	privBytes, err := x509.MarshalPKCS8PrivateKey(key)
+	assert.NoError(err)
+	pubBytes, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	assert.NoError(err)
+
+	keyFile := filepath.Join(dir, "key.pem")
+	pubFile := filepath.Join(dir, "pub.pem")
+	assert.NoError(os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privBytes}), 0600))
+	assert.NoError(os.WriteFile(pubFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubBytes}), 0600))
+
+	var conf config.Config
+	conf.FUOTAServer.Signing.KeyFile = keyFile
+	conf.FUOTAServer.Signing.TrustedKeyFiles = []string{pubFile}
+	assert.NoError(Setup(&conf))
+	defer func() {
+		signer = nil
+		SetTrustedKeys(nil)
+	}()
+
+	assert.True(HasTrustedKeys())
+
+	b, err := Wrap([]byte("firmware"))
+	assert.NoError(err)
+
+	payload, err := Unwrap(b, &key.PublicKey)
+	assert.NoError(err)
+	assert.Equal([]byte("firmware"), payload)
+}
+
+func TestWrap(t *testing.T) {
+	assert := require.New(t)
+
+	_, err := Wrap([]byte("firmware"))
+	assert.Equal(ErrNoSigner, err)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(err)
+	assert.NoError(SetSigner(key))
+	defer func() { signer = nil }()
+
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(err)
+
+	p384Key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	assert.NoError(err)
+	assert.Error(SetSigner(p384Key))
+
+	b, err := Wrap([]byte("firmware"))
+	assert.NoError(err)
+	assert.Len(b, 6+8+64)
+	assert.Equal([]byte{0x01, 0x01, 0x08, 0x00, 0x00, 0x00}, b[:6])
+
+	t.Run("Valid", func(t *testing.T) {
+		assert := require.New(t)
+
+		payload, err := Unwrap(b, &key.PublicKey)
+		assert.NoError(err)
+		assert.Equal([]byte("firmware"), payload)
+	})
+
+	t.Run("Other key", func(t *testing.T) {
+		assert := require.New(t)
+
+		_, err := Unwrap(b, &otherKey.PublicKey)
+		assert.Equal(ErrInvalidSignature, err)
+	})
+
+	t.Run("Modified payload", func(t *testing.T) {
+		assert := require.New(t)
+
+		modified := append([]byte{}, b...)
+		modified[6] ^= 0xff
+
+		_, err := Unwrap(modified, &key.PublicKey)
+		assert.Equal(ErrInvalidSignature, err)
+	})
+
+	t.Run("Truncated", func(t *testing.T) {
+		assert := require.New(t)
+
+		_, err := Unwrap(b[:len(b)-1], &key.PublicKey)
+		assert.Equal(ErrInvalidEnvelope, err)
+	})
+}
+
+func TestVerify(t *testing.T) {
+	assert := require.New(t)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(err)
+
+	payload := []byte("firmware")
+	digest := sha256.Sum256(payload)
+
+	der, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	assert.NoError(err)
+	raw, err := rawSignature(der)
+	assert.NoError(err)
+
+	assert.Equal(ErrNoTrustedKeys, Verify(payload, der))
+
+	SetTrustedKeys([]*ecdsa.PublicKey{&otherKey.PublicKey, &key.PublicKey})
+	defer SetTrustedKeys(nil)
+
+	assert.NoError(Verify(payload, der))
+	assert.NoError(Verify(payload, raw))
+	assert.Equal(ErrInvalidSignature, Verify([]byte("other firmware"), der))
+
+	SetTrustedKeys([]*ecdsa.PublicKey{&otherKey.PublicKey})
+	assert.Equal(ErrInvalidSignature, Verify(payload, raw))
+}
@@ -0,0 +1,193 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+	log "github.com/sirupsen/logrus"
+)
+
+// Campaign represents a staged rollout of a FUOTA payload. The devices of a
+// campaign are split into waves, each wave is a separate deployment.
+type Campaign struct {
+	ID               uuid.UUID     `db:"id"`
+	CreatedAt        time.Time     `db:"created_at"`
+	UpdatedAt        time.Time     `db:"updated_at"`
+	ApplicationID    string        `db:"application_id"`
+	SuccessThreshold float64       `db:"success_threshold"`
+	State            CampaignState `db:"state"`
+	ErrorMessage     string        `db:"error_message"`
+	CompletedAt      *time.Time    `db:"completed_at"`
+}
+
+// CampaignState defines the state of a campaign.
+type CampaignState string
+
+// Campaign states.
+const (
+	CampaignStateRunning   CampaignState = "RUNNING"
+	CampaignStateCompleted CampaignState = "COMPLETED"
+	CampaignStateHalted    CampaignState = "HALTED"
+	CampaignStateFailed    CampaignState = "FAILED"
+)
+
+// CampaignWave represents a wave (deployment) within a campaign.
+type CampaignWave struct {
+	Wave              int             `db:"campaign_wave"`
+	DeploymentID      uuid.UUID       `db:"id"`
+	State             DeploymentState `db:"state"`
+	DeviceCount       int             `db:"device_count"`
+	FragStatusSuccess int             `db:"frag_status_success"`
+}
+
+// CreateCampaign creates the given Campaign.
+func CreateCampaign(ctx context.Context, db sqlx.Execer, c *Campaign) error {
+	if c.State == "" {
+		c.State = CampaignStateRunning
+	}
+
+	if c.ID == uuid.Nil {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return fmt.Errorf("new uuid error: %w", err)
+		}
+
+		c.ID = id
+	}
+
+	now := time.Now().Round(time.Millisecond)
+	c.CreatedAt = now
+	c.UpdatedAt = now
+
+	_, err := db.Exec(`
+		insert into campaign (
+			id,
+			created_at,
+			updated_at,
+			application_id,
+			success_threshold,
+			state,
+			error_message,
+			completed_at
+		) values ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		c.ID,
+		c.CreatedAt,
+		c.UpdatedAt,
+		c.ApplicationID,
+		c.SuccessThreshold,
+		c.State,
+		c.ErrorMessage,
+		c.CompletedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("sql exec error: %w", err)
+	}
+
+	log.WithFields(log.Fields{
+		"id": c.ID,
+	}).Info("storage: campaign created")
+
+	return nil
+}
+
+// GetCampaign returns the Campaign given an ID.
+func GetCampaign(ctx context.Context, db sqlx.Queryer, id uuid.UUID) (Campaign, error) {
+	var c Campaign
+	err := sqlx.Get(db, &c, "select * from campaign where id = $1", id)
+	if err != nil {
+		return c, fmt.Errorf("sql select error: %w", err)
+	}
+
+	return c, nil
+}
+
+// UpdateCampaign updates the given Campaign.
+func UpdateCampaign(ctx context.Context, db sqlx.Execer, c *Campaign) error {
+	c.UpdatedAt = time.Now()
+
+	res, err := db.Exec(`
+		update campaign set
+			updated_at = $2,
+			state = $3,
+			error_message = $4,
+			completed_at = $5
+		where
+			id = $1`,
+		c.ID,
+		c.UpdatedAt,
+		c.State,
+		c.ErrorMessage,
+		c.CompletedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("sql update error: %w", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("get rows affected error: %w", err)
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"id": c.ID,
+	}).Info("storage: campaign updated")
+
+	return nil
+}
+
+// GetRunningCampaignIDs returns the IDs of the campaigns that are running.
+func GetRunningCampaignIDs(ctx context.Context, db sqlx.Queryer) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := sqlx.Select(db, &ids, `
+		select
+			id
+		from
+			campaign
+		where
+			state = $1
+		order by
+			created_at`,
+		CampaignStateRunning,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("sql select error: %w", err)
+	}
+
+	return ids, nil
+}
+
+// GetCampaignWaves returns the waves of the given campaign ID, ordered by
+// wave. The FragStatusSuccess field contains the number of devices that
+// reported a successful fragmentation-session status.
+func GetCampaignWaves(ctx context.Context, db sqlx.Queryer, campaignID uuid.UUID) ([]CampaignWave, error) {
+	var waves []CampaignWave
+	err := sqlx.Select(db, &waves, `
+		select
+			d.campaign_wave,
+			d.id,
+			d.state,
+			count(dd.dev_eui) as device_count,
+			count(dd.frag_status_completed_at) as frag_status_success
+		from
+			deployment d
+		left join deployment_device dd
+			on dd.deployment_id = d.id
+		where
+			d.campaign_id = $1
+		group by
+			d.id
+		order by
+			d.campaign_wave`,
+		campaignID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("sql select error: %w", err)
+	}
+
+	return waves, nil
+}
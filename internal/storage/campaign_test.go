@@ -0,0 +1,94 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestCampaign() {
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+
+		c := Campaign{
+			ApplicationID:    "app-1",
+			SuccessThreshold: 90,
+		}
+		assert.NoError(CreateCampaign(context.Background(), ts.Tx(), &c))
+		assert.NotEqual(uuid.Nil, c.ID)
+		assert.Equal(CampaignStateRunning, c.State)
+
+		c.CreatedAt = c.CreatedAt.UTC()
+		c.UpdatedAt = c.UpdatedAt.UTC()
+
+		t.Run("Get", func(t *testing.T) {
+			assert := require.New(t)
+
+			cGet, err := GetCampaign(context.Background(), ts.Tx(), c.ID)
+			assert.NoError(err)
+
+			cGet.CreatedAt = cGet.CreatedAt.UTC()
+			cGet.UpdatedAt = cGet.UpdatedAt.UTC()
+
+			assert.Equal(c, cGet)
+		})
+
+		t.Run("GetRunningCampaignIDs", func(t *testing.T) {
+			assert := require.New(t)
+
+			ids, err := GetRunningCampaignIDs(context.Background(), ts.Tx())
+			assert.NoError(err)
+			assert.Equal([]uuid.UUID{c.ID}, ids)
+		})
+
+		t.Run("GetCampaignWaves", func(t *testing.T) {
+			assert := require.New(t)
+
+			now := time.Now()
+			d1 := Deployment{CampaignID: &c.ID, CampaignWave: 1}
+			d2 := Deployment{CampaignID: &c.ID, CampaignWave: 0, State: DeploymentStateCompleted}
+			assert.NoError(CreateDeployment(context.Background(), ts.Tx(), &d1))
+			assert.NoError(CreateDeployment(context.Background(), ts.Tx(), &d2))
+
+			for i, completedAt := range []*time.Time{&now, &now, nil} {
+				assert.NoError(CreateDeploymentDevice(context.Background(), ts.Tx(), &DeploymentDevice{
+					DeploymentID:          d2.ID,
+					DevEUI:                lorawan.EUI64{byte(i)},
+					FragStatusCompletedAt: completedAt,
+				}))
+			}
+
+			waves, err := GetCampaignWaves(context.Background(), ts.Tx(), c.ID)
+			assert.NoError(err)
+			assert.Equal([]CampaignWave{
+				{Wave: 0, DeploymentID: d2.ID, State: DeploymentStateCompleted, DeviceCount: 3, FragStatusSuccess: 2},
+				{Wave: 1, DeploymentID: d1.ID, State: DeploymentStatePending},
+			}, waves)
+		})
+
+		t.Run("Update", func(t *testing.T) {
+			assert := require.New(t)
+
+			now := time.Now().Round(time.Millisecond).UTC()
+			c.State = CampaignStateHalted
+			c.ErrorMessage = "success rate below threshold"
+			c.CompletedAt = &now
+			assert.NoError(UpdateCampaign(context.Background(), ts.Tx(), &c))
+
+			cGet, err := GetCampaign(context.Background(), ts.Tx(), c.ID)
+			assert.NoError(err)
+			assert.Equal(CampaignStateHalted, cGet.State)
+			assert.Equal("success rate below threshold", cGet.ErrorMessage)
+			assert.True(now.Equal(*cGet.CompletedAt))
+
+			ids, err := GetRunningCampaignIDs(context.Background(), ts.Tx())
+			assert.NoError(err)
+			assert.Len(ids, 0)
+		})
+	})
+}
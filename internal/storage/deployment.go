@@ -3,11 +3,14 @@ package storage
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/jmoiron/sqlx"
 	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
 )
 
 // Deployment represents a FUOTA deployment.
@@ -20,10 +23,167 @@ type Deployment struct {
 	FragSessionSetupCompletedAt *time.Time `db:"frag_session_setup_completed_at"`
 	EnqueueCompletedAt          *time.Time `db:"enqueue_completed_at"`
 	FragStatusCompletedAt       *time.Time `db:"frag_status_completed_at"`
+	DeviceCleanupCompletedAt    *time.Time `db:"device_cleanup_completed_at"`
+	PackageVersionCompletedAt   *time.Time `db:"package_version_completed_at"`
+	MCGroupStatusCompletedAt    *time.Time `db:"mc_group_status_completed_at"`
+	FragRepairCompletedAt       *time.Time `db:"frag_repair_completed_at"`
+	DevVersionCompletedAt       *time.Time `db:"dev_version_completed_at"`
+	UpgradeImageCompletedAt     *time.Time `db:"upgrade_image_completed_at"`
+	RebootCompletedAt           *time.Time `db:"reboot_completed_at"`
+	FWVersionCompletedAt        *time.Time `db:"fw_version_completed_at"`
+
+	// Deployment options.
+	ApplicationID                     string        `db:"application_id"`
+	MulticastGroupType                string        `db:"multicast_group_type"`
+	MulticastDR                       uint8         `db:"multicast_dr"`
+	MulticastPingSlotPeriodicity      uint8         `db:"multicast_ping_slot_periodicity"`
+	MulticastFrequency                uint32        `db:"multicast_frequency"`
+	MCGroupID                         uint8         `db:"mc_group_id"`
+	MulticastTimeout                  uint8         `db:"multicast_timeout"`
+	MulticastRegion                   string        `db:"multicast_region"`
+	UnicastTimeout                    time.Duration `db:"unicast_timeout"`
+	UnicastAttemptCount               int           `db:"unicast_attempt_count"`
+	FragSize                          int           `db:"frag_size"`
+	Payload                           []byte        `db:"payload"`
+	PayloadSize                       int           `db:"payload_size"`
+	PayloadSHA256                     []byte        `db:"payload_sha256"`
+	Compression                       string        `db:"compression"`
+	CompressedPayloadSize             int           `db:"compressed_payload_size"`
+	PayloadSigned                     bool          `db:"payload_signed"`
+	ChunkSize                         int           `db:"chunk_size"`
+	ChunkCount                        int           `db:"chunk_count"`
+	Redundancy                        int           `db:"redundancy"`
+	FragmentationSessionIndex         uint8         `db:"fragmentation_session_index"`
+	FragmentationMatrix               uint8         `db:"fragmentation_matrix"`
+	BlockAckDelay                     uint8         `db:"block_ack_delay"`
+	Descriptor                        []byte        `db:"descriptor"`
+	RequestFragmentationSessionStatus string        `db:"request_fragmentation_session_status"`
+	RequestPackageVersions            bool          `db:"request_package_versions"`
+	AutoMCGroupID                     bool          `db:"auto_mc_group_id"`
+	RepairAttemptCount                int           `db:"repair_attempt_count"`
+	FragmentationPackageVersion       uint8         `db:"fragmentation_package_version"`
+	MulticastSetupPackageVersion      uint8         `db:"multicast_setup_package_version"`
+	FirmwareManagement                bool          `db:"firmware_management"`
+	FirmwareVersion                   uint32        `db:"firmware_version"`
+	RebootAt                          *time.Time    `db:"reboot_at"`
+	RebootCountdown                   time.Duration `db:"reboot_countdown"`
+
+	// Deployment state.
+	MulticastGroupID      string             `db:"multicast_group_id"`
+	MCAddr                lorawan.DevAddr    `db:"mc_addr"`
+	MCKey                 EncryptedAES128Key `db:"mc_key"`
+	SessionStartTime      *time.Time         `db:"session_start_time"`
+	SessionEndTime        *time.Time         `db:"session_end_time"`
+	MCGroupDevicesAddedAt *time.Time         `db:"mc_group_devices_added_at"`
+	MCGroupDeletedAt      *time.Time         `db:"mc_group_deleted_at"`
+	CompletedAt           *time.Time         `db:"completed_at"`
+	CancelledAt           *time.Time         `db:"cancelled_at"`
+	State                 DeploymentState    `db:"state"`
+	ErrorMessage          string             `db:"error_message"`
+	ParentDeploymentID    *uuid.UUID         `db:"parent_deployment_id"`
+	StartAt               *time.Time         `db:"start_at"`
+	CampaignID            *uuid.UUID         `db:"campaign_id"`
+	CampaignWave          int                `db:"campaign_wave"`
+	FirmwareID            *uuid.UUID         `db:"firmware_id"`
+	RepairFragCount       int                `db:"repair_frag_count"`
+	ChunksCompleted       int                `db:"chunks_completed"`
+}
+
+// DeploymentState defines the state of a deployment.
+type DeploymentState string
+
+// Deployment states.
+const (
+	DeploymentStatePending            DeploymentState = "PENDING"
+	DeploymentStateRunning            DeploymentState = "RUNNING"
+	DeploymentStateCompleted          DeploymentState = "COMPLETED"
+	DeploymentStatePartiallyCompleted DeploymentState = "PARTIALLY_COMPLETED"
+	DeploymentStateFailed             DeploymentState = "FAILED"
+	DeploymentStateCancelled          DeploymentState = "CANCELLED"
+
+	// DeploymentStateUnknown is set for the deployments that were created
+	// before the deployment state was stored.
+	DeploymentStateUnknown DeploymentState = "UNKNOWN"
+)
+
+// DeploymentListItem defines the Deployment as returned by GetDeployments.
+type DeploymentListItem struct {
+	ID            uuid.UUID       `db:"id"`
+	CreatedAt     time.Time       `db:"created_at"`
+	UpdatedAt     time.Time       `db:"updated_at"`
+	ApplicationID string          `db:"application_id"`
+	State         DeploymentState `db:"state"`
+}
+
+// DeploymentFilters provides filters for filtering deployments.
+type DeploymentFilters struct {
+	ApplicationID string            `db:"application_id"`
+	States        []DeploymentState `db:"-"`
+	CreatedAfter  *time.Time        `db:"created_after"`
+	CreatedBefore *time.Time        `db:"created_before"`
+	DevEUI        *lorawan.EUI64    `db:"dev_eui"`
+
+	// Limit and Offset are added for convenience so that this struct can
+	// be given as the arguments. A Limit of 0 returns all items.
+	Limit  int `db:"limit"`
+	Offset int `db:"offset"`
+}
+
+// SQL returns the SQL filters.
+func (f DeploymentFilters) SQL() string {
+	var filters []string
+
+	if f.ApplicationID != "" {
+		filters = append(filters, "d.application_id = :application_id")
+	}
+
+	if len(f.States) != 0 {
+		// only known states are added to the query
+		var states []string
+		for _, s := range f.States {
+			switch s {
+			case DeploymentStatePending,
+				DeploymentStateRunning,
+				DeploymentStateCompleted,
+				DeploymentStatePartiallyCompleted,
+				DeploymentStateFailed,
+				DeploymentStateCancelled,
+				DeploymentStateUnknown:
+				states = append(states, "'"+string(s)+"'")
+			}
+		}
+		if len(states) == 0 {
+			filters = append(filters, "false")
+		} else {
+			filters = append(filters, "d.state in ("+strings.Join(states, ", ")+")")
+		}
+	}
+
+	if f.CreatedAfter != nil {
+		filters = append(filters, "d.created_at >= :created_after")
+	}
+
+	if f.CreatedBefore != nil {
+		filters = append(filters, "d.created_at < :created_before")
+	}
+
+	if f.DevEUI != nil {
+		filters = append(filters, "exists (select 1 from deployment_device dd where dd.deployment_id = d.id and dd.dev_eui = :dev_eui)")
+	}
+
+	if len(filters) == 0 {
+		return ""
+	}
+
+	return "where " + strings.Join(filters, " and ")
 }
 
 // CreateDeployment creates the given Deployment.
 func CreateDeployment(ctx context.Context, db sqlx.Execer, d *Deployment) error {
+	if d.State == "" {
+		d.State = DeploymentStatePending
+	}
+
 	if d.ID == uuid.Nil {
 		id, err := uuid.NewV4()
 		if err != nil {
@@ -46,8 +206,67 @@ func CreateDeployment(ctx context.Context, db sqlx.Execer, d *Deployment) error
 			mc_session_completed_at,
 			frag_session_setup_completed_at,
 			enqueue_completed_at,
-			frag_status_completed_at
-		) values ($1, $2, $3, $4, $5, $6, $7, $8)`,
+			frag_status_completed_at,
+			device_cleanup_completed_at,
+			application_id,
+			multicast_group_type,
+			multicast_dr,
+			multicast_ping_slot_periodicity,
+			multicast_frequency,
+			mc_group_id,
+			multicast_timeout,
+			multicast_region,
+			unicast_timeout,
+			unicast_attempt_count,
+			frag_size,
+			payload,
+			payload_size,
+			payload_sha256,
+			redundancy,
+			fragmentation_session_index,
+			fragmentation_matrix,
+			block_ack_delay,
+			descriptor,
+			request_fragmentation_session_status,
+			multicast_group_id,
+			mc_addr,
+			mc_key,
+			session_start_time,
+			session_end_time,
+			mc_group_devices_added_at,
+			mc_group_deleted_at,
+			completed_at,
+			cancelled_at,
+			state,
+			error_message,
+			parent_deployment_id,
+			start_at,
+			campaign_id,
+			campaign_wave,
+			request_package_versions,
+			package_version_completed_at,
+			auto_mc_group_id,
+			mc_group_status_completed_at,
+			repair_attempt_count,
+			repair_frag_count,
+			frag_repair_completed_at,
+			fragmentation_package_version,
+			multicast_setup_package_version,
+			firmware_management,
+			firmware_version,
+			reboot_at,
+			reboot_countdown,
+			dev_version_completed_at,
+			upgrade_image_completed_at,
+			reboot_completed_at,
+			fw_version_completed_at,
+			firmware_id,
+			compression,
+			compressed_payload_size,
+			payload_signed,
+			chunk_size,
+			chunk_count
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33, $34, $35, $36, $37, $38, $39, $40, $41, $42, $43, $44, $45, $46, $47, $48, $49, $50, $51, $52, $53, $54, $55, $56, $57, $58, $59, $60, $61, $62, $63, $64, $65, $66, $67)`,
 		d.ID,
 		d.CreatedAt,
 		d.UpdatedAt,
@@ -56,6 +275,65 @@ func CreateDeployment(ctx context.Context, db sqlx.Execer, d *Deployment) error
 		d.FragSessionSetupCompletedAt,
 		d.EnqueueCompletedAt,
 		d.FragStatusCompletedAt,
+		d.DeviceCleanupCompletedAt,
+		d.ApplicationID,
+		d.MulticastGroupType,
+		d.MulticastDR,
+		d.MulticastPingSlotPeriodicity,
+		d.MulticastFrequency,
+		d.MCGroupID,
+		d.MulticastTimeout,
+		d.MulticastRegion,
+		d.UnicastTimeout,
+		d.UnicastAttemptCount,
+		d.FragSize,
+		d.Payload,
+		d.PayloadSize,
+		d.PayloadSHA256,
+		d.Redundancy,
+		d.FragmentationSessionIndex,
+		d.FragmentationMatrix,
+		d.BlockAckDelay,
+		d.Descriptor,
+		d.RequestFragmentationSessionStatus,
+		d.MulticastGroupID,
+		d.MCAddr,
+		d.MCKey,
+		d.SessionStartTime,
+		d.SessionEndTime,
+		d.MCGroupDevicesAddedAt,
+		d.MCGroupDeletedAt,
+		d.CompletedAt,
+		d.CancelledAt,
+		d.State,
+		d.ErrorMessage,
+		d.ParentDeploymentID,
+		d.StartAt,
+		d.CampaignID,
+		d.CampaignWave,
+		d.RequestPackageVersions,
+		d.PackageVersionCompletedAt,
+		d.AutoMCGroupID,
+		d.MCGroupStatusCompletedAt,
+		d.RepairAttemptCount,
+		d.RepairFragCount,
+		d.FragRepairCompletedAt,
+		d.FragmentationPackageVersion,
+		d.MulticastSetupPackageVersion,
+		d.FirmwareManagement,
+		d.FirmwareVersion,
+		d.RebootAt,
+		d.RebootCountdown,
+		d.DevVersionCompletedAt,
+		d.UpgradeImageCompletedAt,
+		d.RebootCompletedAt,
+		d.FWVersionCompletedAt,
+		d.FirmwareID,
+		d.Compression,
+		d.CompressedPayloadSize,
+		d.PayloadSigned,
+		d.ChunkSize,
+		d.ChunkCount,
 	)
 	if err != nil {
 		return fmt.Errorf("sql exec error: %w", err)
@@ -90,7 +368,30 @@ func UpdateDeployment(ctx context.Context, db sqlx.Execer, d *Deployment) error
 			mc_session_completed_at = $4,
 			frag_session_setup_completed_at = $5,
 			enqueue_completed_at = $6,
-			frag_status_completed_at = $7
+			frag_status_completed_at = $7,
+			multicast_group_id = $8,
+			mc_addr = $9,
+			mc_key = $10,
+			session_start_time = $11,
+			session_end_time = $12,
+			mc_group_devices_added_at = $13,
+			mc_group_deleted_at = $14,
+			completed_at = $15,
+			cancelled_at = $16,
+			state = $17,
+			error_message = $18,
+			start_at = $19,
+			device_cleanup_completed_at = $20,
+			package_version_completed_at = $21,
+			mc_group_status_completed_at = $22,
+			mc_group_id = $23,
+			repair_frag_count = $24,
+			frag_repair_completed_at = $25,
+			dev_version_completed_at = $26,
+			upgrade_image_completed_at = $27,
+			reboot_completed_at = $28,
+			fw_version_completed_at = $29,
+			chunks_completed = $30
 		where
 			id = $1`,
 		d.ID,
@@ -100,6 +401,29 @@ func UpdateDeployment(ctx context.Context, db sqlx.Execer, d *Deployment) error
 		d.FragSessionSetupCompletedAt,
 		d.EnqueueCompletedAt,
 		d.FragStatusCompletedAt,
+		d.MulticastGroupID,
+		d.MCAddr,
+		d.MCKey,
+		d.SessionStartTime,
+		d.SessionEndTime,
+		d.MCGroupDevicesAddedAt,
+		d.MCGroupDeletedAt,
+		d.CompletedAt,
+		d.CancelledAt,
+		d.State,
+		d.ErrorMessage,
+		d.StartAt,
+		d.DeviceCleanupCompletedAt,
+		d.PackageVersionCompletedAt,
+		d.MCGroupStatusCompletedAt,
+		d.MCGroupID,
+		d.RepairFragCount,
+		d.FragRepairCompletedAt,
+		d.DevVersionCompletedAt,
+		d.UpgradeImageCompletedAt,
+		d.RebootCompletedAt,
+		d.FWVersionCompletedAt,
+		d.ChunksCompleted,
 	)
 	if err != nil {
 		return fmt.Errorf("sql update error: %w", err)
@@ -118,3 +442,105 @@ func UpdateDeployment(ctx context.Context, db sqlx.Execer, d *Deployment) error
 
 	return nil
 }
+
+// GetUnfinishedDeploymentIDs returns the IDs of the deployments that are
+// running. Pending deployments are started by the scheduler.
+func GetUnfinishedDeploymentIDs(ctx context.Context, db sqlx.Queryer) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := sqlx.Select(db, &ids, `
+		select
+			id
+		from
+			deployment
+		where
+			state = $1
+		order by
+			created_at`,
+		DeploymentStateRunning,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("sql select error: %w", err)
+	}
+
+	return ids, nil
+}
+
+// GetDeploymentCount returns the number of deployments matching the given
+// filters.
+func GetDeploymentCount(ctx context.Context, db sqlx.Queryer, filters DeploymentFilters) (int, error) {
+	query, args, err := sqlx.BindNamed(sqlx.DOLLAR, `
+		select
+			count(*)
+		from
+			deployment d
+		`+filters.SQL(), filters)
+	if err != nil {
+		return 0, fmt.Errorf("named query error: %w", err)
+	}
+
+	var count int
+	if err := sqlx.Get(db, &count, query, args...); err != nil {
+		return 0, fmt.Errorf("sql select error: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetDeployments returns a slice of deployments matching the given filters,
+// ordered by creation time (most recent first).
+func GetDeployments(ctx context.Context, db sqlx.Queryer, filters DeploymentFilters) ([]DeploymentListItem, error) {
+	limit := ""
+	if filters.Limit != 0 {
+		limit = "limit :limit"
+	}
+
+	query, args, err := sqlx.BindNamed(sqlx.DOLLAR, `
+		select
+			d.id,
+			d.created_at,
+			d.updated_at,
+			d.application_id,
+			d.state
+		from
+			deployment d
+		`+filters.SQL()+`
+		order by
+			d.created_at desc,
+			d.id
+		`+limit+`
+		offset :offset`, filters)
+	if err != nil {
+		return nil, fmt.Errorf("named query error: %w", err)
+	}
+
+	var items []DeploymentListItem
+	if err := sqlx.Select(db, &items, query, args...); err != nil {
+		return nil, fmt.Errorf("sql select error: %w", err)
+	}
+
+	return items, nil
+}
+
+// GetDueDeploymentIDs returns the IDs of the pending deployments of which the
+// start time is at or before the given time.
+func GetDueDeploymentIDs(ctx context.Context, db sqlx.Queryer, now time.Time) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := sqlx.Select(db, &ids, `
+		select
+			id
+		from
+			deployment
+		where
+			state = $1
+			and start_at <= $2
+		order by
+			start_at`,
+		DeploymentStatePending,
+		now,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("sql select error: %w", err)
+	}
+
+	return ids, nil
+}
@@ -14,18 +14,84 @@ import (
 
 // DeploymentDevice represents a device within a FUOTA deployment.
 type DeploymentDevice struct {
-	DeploymentID                uuid.UUID     `db:"deployment_id"`
-	DevEUI                      lorawan.EUI64 `db:"dev_eui"`
-	CreatedAt                   time.Time     `db:"created_at"`
-	UpdatedAt                   time.Time     `db:"updated_at"`
-	MCGroupSetupCompletedAt     *time.Time    `db:"mc_group_setup_completed_at"`
-	MCSessionCompletedAt        *time.Time    `db:"mc_session_completed_at"`
-	FragSessionSetupCompletedAt *time.Time    `db:"frag_session_setup_completed_at"`
-	FragStatusCompletedAt       *time.Time    `db:"frag_status_completed_at"`
+	DeploymentID                uuid.UUID                     `db:"deployment_id"`
+	DevEUI                      lorawan.EUI64                 `db:"dev_eui"`
+	MCRootKey                   EncryptedAES128Key            `db:"mc_root_key"`
+	CreatedAt                   time.Time                     `db:"created_at"`
+	UpdatedAt                   time.Time                     `db:"updated_at"`
+	MCGroupSetupCompletedAt     *time.Time                    `db:"mc_group_setup_completed_at"`
+	MCSessionCompletedAt        *time.Time                    `db:"mc_session_completed_at"`
+	FragSessionSetupCompletedAt *time.Time                    `db:"frag_session_setup_completed_at"`
+	FragStatusCompletedAt       *time.Time                    `db:"frag_status_completed_at"`
+	PackageVersionCompletedAt   *time.Time                    `db:"package_version_completed_at"`
+	MCGroupStatusCompletedAt    *time.Time                    `db:"mc_group_status_completed_at"`
+	FragDataBlockReceivedAt     *time.Time                    `db:"frag_data_block_received_at"`
+	FragSessionCnt              int                           `db:"frag_session_cnt"`
+	ChunksCompleted             int                           `db:"chunks_completed"`
+	HWVersion                   *uint32                       `db:"hw_version"`
+	FWVersionBefore             *uint32                       `db:"fw_version_before"`
+	FWVersionNext               *uint32                       `db:"fw_version_next"`
+	FWVersionAfter              *uint32                       `db:"fw_version_after"`
+	UpgradeImageCompletedAt     *time.Time                    `db:"upgrade_image_completed_at"`
+	RebootCompletedAt           *time.Time                    `db:"reboot_completed_at"`
+	FWVersionCompletedAt        *time.Time                    `db:"fw_version_completed_at"`
+	State                       DeploymentDeviceState         `db:"state"`
+	FailureReason               DeploymentDeviceFailureReason `db:"failure_reason"`
 }
 
+// DeploymentDeviceState defines the state of a device within a deployment.
+type DeploymentDeviceState string
+
+// Deployment device states.
+const (
+	DeploymentDeviceStatePending   DeploymentDeviceState = "PENDING"
+	DeploymentDeviceStateCompleted DeploymentDeviceState = "COMPLETED"
+	DeploymentDeviceStateFailed    DeploymentDeviceState = "FAILED"
+
+	// DeploymentDeviceStateUnknown is set for the devices of the deployments
+	// that were created before the device state was stored.
+	DeploymentDeviceStateUnknown DeploymentDeviceState = "UNKNOWN"
+)
+
+// DeploymentDeviceFailureReason defines the reason why a device failed the
+// deployment.
+type DeploymentDeviceFailureReason string
+
+// Deployment device failure reasons.
+const (
+	FailureReasonNone                                   DeploymentDeviceFailureReason = ""
+	FailureReasonMcGroupSetupIDError                    DeploymentDeviceFailureReason = "MC_GROUP_SETUP_ID_ERROR"
+	FailureReasonMcGroupSetupNoAnswer                   DeploymentDeviceFailureReason = "MC_GROUP_SETUP_NO_ANSWER"
+	FailureReasonFragSessionSetupWrongDescriptor        DeploymentDeviceFailureReason = "FRAG_SESSION_SETUP_WRONG_DESCRIPTOR"
+	FailureReasonFragSessionSetupIndexNotSupported      DeploymentDeviceFailureReason = "FRAG_SESSION_SETUP_INDEX_NOT_SUPPORTED"
+	FailureReasonFragSessionSetupNotEnoughMemory        DeploymentDeviceFailureReason = "FRAG_SESSION_SETUP_NOT_ENOUGH_MEMORY"
+	FailureReasonFragSessionSetupEncodingUnsupported    DeploymentDeviceFailureReason = "FRAG_SESSION_SETUP_ENCODING_UNSUPPORTED"
+	FailureReasonFragSessionSetupNoAnswer               DeploymentDeviceFailureReason = "FRAG_SESSION_SETUP_NO_ANSWER"
+	FailureReasonMcSessionMcGroupUndefined              DeploymentDeviceFailureReason = "MC_SESSION_MC_GROUP_UNDEFINED"
+	FailureReasonMcSessionFreqError                     DeploymentDeviceFailureReason = "MC_SESSION_FREQ_ERROR"
+	FailureReasonMcSessionDRError                       DeploymentDeviceFailureReason = "MC_SESSION_DR_ERROR"
+	FailureReasonMcSessionNoAnswer                      DeploymentDeviceFailureReason = "MC_SESSION_NO_ANSWER"
+	FailureReasonFragSessionStatusMissingFrag           DeploymentDeviceFailureReason = "FRAG_SESSION_STATUS_MISSING_FRAG"
+	FailureReasonFragSessionStatusNotEnoughMatrixMemory DeploymentDeviceFailureReason = "FRAG_SESSION_STATUS_NOT_ENOUGH_MATRIX_MEMORY"
+	FailureReasonFragSessionStatusNoAnswer              DeploymentDeviceFailureReason = "FRAG_SESSION_STATUS_NO_ANSWER"
+	FailureReasonPackageVersionIncompatible             DeploymentDeviceFailureReason = "PACKAGE_VERSION_INCOMPATIBLE"
+	FailureReasonPackageVersionNoAnswer                 DeploymentDeviceFailureReason = "PACKAGE_VERSION_NO_ANSWER"
+	FailureReasonUpgradeImageNoFirmware                 DeploymentDeviceFailureReason = "UPGRADE_IMAGE_NO_FIRMWARE"
+	FailureReasonUpgradeImageInvalid                    DeploymentDeviceFailureReason = "UPGRADE_IMAGE_INVALID"
+	FailureReasonUpgradeImageIncorrectHardware          DeploymentDeviceFailureReason = "UPGRADE_IMAGE_INCORRECT_HARDWARE"
+	FailureReasonUpgradeImageVersionMismatch            DeploymentDeviceFailureReason = "UPGRADE_IMAGE_VERSION_MISMATCH"
+	FailureReasonUpgradeImageNoAnswer                   DeploymentDeviceFailureReason = "UPGRADE_IMAGE_NO_ANSWER"
+	FailureReasonRebootNoAnswer                         DeploymentDeviceFailureReason = "REBOOT_NO_ANSWER"
+	FailureReasonFWVersionMismatch                      DeploymentDeviceFailureReason = "FW_VERSION_MISMATCH"
+	FailureReasonFWVersionNoAnswer                      DeploymentDeviceFailureReason = "FW_VERSION_NO_ANSWER"
+)
+
 // CreateDeploymentDevice creates the given DeploymentDevice.
 func CreateDeploymentDevice(ctx context.Context, db sqlx.Execer, dd *DeploymentDevice) error {
+	if dd.State == "" {
+		dd.State = DeploymentDeviceStatePending
+	}
+
 	now := time.Now().Round(time.Millisecond)
 	dd.CreatedAt = now
 	dd.UpdatedAt = now
@@ -34,21 +100,49 @@ func CreateDeploymentDevice(ctx context.Context, db sqlx.Execer, dd *DeploymentD
 		insert into deployment_device (
 			deployment_id,
 			dev_eui,
+			mc_root_key,
 			created_at,
 			updated_at,
 			mc_group_setup_completed_at,
 			mc_session_completed_at,
 			frag_session_setup_completed_at,
-			frag_status_completed_at
-		) values ($1, $2, $3, $4, $5, $6, $7, $8)`,
+			frag_status_completed_at,
+			state,
+			failure_reason,
+			package_version_completed_at,
+			mc_group_status_completed_at,
+			frag_data_block_received_at,
+			frag_session_cnt,
+			hw_version,
+			fw_version_before,
+			fw_version_next,
+			fw_version_after,
+			upgrade_image_completed_at,
+			reboot_completed_at,
+			fw_version_completed_at
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)`,
 		dd.DeploymentID,
 		dd.DevEUI,
+		dd.MCRootKey,
 		dd.CreatedAt,
 		dd.UpdatedAt,
 		dd.MCGroupSetupCompletedAt,
 		dd.MCSessionCompletedAt,
 		dd.FragSessionSetupCompletedAt,
 		dd.FragStatusCompletedAt,
+		dd.State,
+		dd.FailureReason,
+		dd.PackageVersionCompletedAt,
+		dd.MCGroupStatusCompletedAt,
+		dd.FragDataBlockReceivedAt,
+		dd.FragSessionCnt,
+		dd.HWVersion,
+		dd.FWVersionBefore,
+		dd.FWVersionNext,
+		dd.FWVersionAfter,
+		dd.UpgradeImageCompletedAt,
+		dd.RebootCompletedAt,
+		dd.FWVersionCompletedAt,
 	)
 	if err != nil {
 		return fmt.Errorf("sql exec error: %w", err)
@@ -83,7 +177,21 @@ func UpdateDeploymentDevice(ctx context.Context, db sqlx.Execer, dd *DeploymentD
 			mc_group_setup_completed_at = $4,
 			mc_session_completed_at = $5,
 			frag_session_setup_completed_at = $6,
-			frag_status_completed_at = $7
+			frag_status_completed_at = $7,
+			state = $8,
+			failure_reason = $9,
+			package_version_completed_at = $10,
+			mc_group_status_completed_at = $11,
+			frag_data_block_received_at = $12,
+			hw_version = $13,
+			fw_version_before = $14,
+			fw_version_next = $15,
+			fw_version_after = $16,
+			upgrade_image_completed_at = $17,
+			reboot_completed_at = $18,
+			fw_version_completed_at = $19,
+			frag_session_cnt = $20,
+			chunks_completed = $21
 		where
 			deployment_id = $1 and dev_eui = $2`,
 		dd.DeploymentID,
@@ -93,6 +201,20 @@ func UpdateDeploymentDevice(ctx context.Context, db sqlx.Execer, dd *DeploymentD
 		dd.MCSessionCompletedAt,
 		dd.FragSessionSetupCompletedAt,
 		dd.FragStatusCompletedAt,
+		dd.State,
+		dd.FailureReason,
+		dd.PackageVersionCompletedAt,
+		dd.MCGroupStatusCompletedAt,
+		dd.FragDataBlockReceivedAt,
+		dd.HWVersion,
+		dd.FWVersionBefore,
+		dd.FWVersionNext,
+		dd.FWVersionAfter,
+		dd.UpgradeImageCompletedAt,
+		dd.RebootCompletedAt,
+		dd.FWVersionCompletedAt,
+		dd.FragSessionCnt,
+		dd.ChunksCompleted,
 	)
 	if err != nil {
 		return fmt.Errorf("sql update error: %w", err)
@@ -123,3 +245,16 @@ func GetDeploymentDevices(ctx context.Context, db sqlx.Queryer, deploymentID uui
 
 	return dds, nil
 }
+
+// GetLastFragSessionCnt returns the highest fragmentation-session counter
+// that was assigned to the given DevEUI over all deployments. It returns 0
+// when no counter has been assigned yet.
+func GetLastFragSessionCnt(ctx context.Context, db sqlx.Queryer, devEUI lorawan.EUI64) (int, error) {
+	var cnt int
+	err := sqlx.Get(db, &cnt, "select coalesce(max(frag_session_cnt), 0) from deployment_device where dev_eui = $1", devEUI)
+	if err != nil {
+		return 0, fmt.Errorf("sql select error: %w", err)
+	}
+
+	return cnt, nil
+}
@@ -0,0 +1,123 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+)
+
+// DeploymentDeviceMCGroup contains a multicast-group that was reported as
+// active by a device (McGroupStatusAns).
+type DeploymentDeviceMCGroup struct {
+	DeploymentID uuid.UUID       `db:"deployment_id"`
+	DevEUI       lorawan.EUI64   `db:"dev_eui"`
+	MCGroupID    uint8           `db:"mc_group_id"`
+	MCAddr       lorawan.DevAddr `db:"mc_addr"`
+	CreatedAt    time.Time       `db:"created_at"`
+}
+
+// CreateDeploymentDeviceMCGroup creates the given DeploymentDeviceMCGroup.
+func CreateDeploymentDeviceMCGroup(ctx context.Context, db sqlx.Execer, g *DeploymentDeviceMCGroup) error {
+	g.CreatedAt = time.Now().Round(time.Millisecond)
+
+	_, err := db.Exec(`
+		insert into deployment_device_mc_group (
+			deployment_id,
+			dev_eui,
+			mc_group_id,
+			mc_addr,
+			created_at
+		) values ($1, $2, $3, $4, $5)`,
+		g.DeploymentID,
+		g.DevEUI,
+		g.MCGroupID,
+		g.MCAddr,
+		g.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("sql exec error: %w", err)
+	}
+
+	log.WithFields(log.Fields{
+		"deployment_id": g.DeploymentID,
+		"dev_eui":       g.DevEUI,
+		"mc_group_id":   g.MCGroupID,
+	}).Info("storage: deployment device multicast-group created")
+
+	return nil
+}
+
+// DeleteDeploymentDeviceMCGroups deletes the multicast-groups for the given
+// deployment ID and DevEUI.
+func DeleteDeploymentDeviceMCGroups(ctx context.Context, db sqlx.Execer, deploymentID uuid.UUID, devEUI lorawan.EUI64) error {
+	_, err := db.Exec(`
+		delete from deployment_device_mc_group
+		where
+			deployment_id = $1
+			and dev_eui = $2`,
+		deploymentID,
+		devEUI,
+	)
+	if err != nil {
+		return fmt.Errorf("sql delete error: %w", err)
+	}
+
+	return nil
+}
+
+// GetDeploymentDeviceMCGroups returns the multicast-groups for the given
+// deployment ID and DevEUI, ordered by multicast-group ID.
+func GetDeploymentDeviceMCGroups(ctx context.Context, db sqlx.Queryer, deploymentID uuid.UUID, devEUI lorawan.EUI64) ([]DeploymentDeviceMCGroup, error) {
+	var groups []DeploymentDeviceMCGroup
+	err := sqlx.Select(db, &groups, `
+		select
+			*
+		from
+			deployment_device_mc_group
+		where
+			deployment_id = $1
+			and dev_eui = $2
+		order by
+			mc_group_id`,
+		deploymentID,
+		devEUI,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("sql select error: %w", err)
+	}
+
+	return groups, nil
+}
+
+// GetDeploymentMCGroupIDsInUse returns the multicast-group IDs that are
+// active on at least one of the devices of the given deployment.
+func GetDeploymentMCGroupIDsInUse(ctx context.Context, db sqlx.Queryer, deploymentID uuid.UUID) ([]uint8, error) {
+	var rows []int
+	err := sqlx.Select(db, &rows, `
+		select
+			distinct mc_group_id
+		from
+			deployment_device_mc_group
+		where
+			deployment_id = $1
+		order by
+			mc_group_id`,
+		deploymentID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("sql select error: %w", err)
+	}
+
+	ids := make([]uint8, 0, len(rows))
+	for _, id := range rows {
+		ids = append(ids, uint8(id))
+	}
+
+	return ids, nil
+}
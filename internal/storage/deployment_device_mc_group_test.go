@@ -0,0 +1,74 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestDeploymentDeviceMCGroup() {
+	assert := require.New(ts.T())
+
+	d := Deployment{}
+	assert.NoError(CreateDeployment(context.Background(), ts.Tx(), &d))
+
+	dd1 := DeploymentDevice{
+		DeploymentID: d.ID,
+		DevEUI:       lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1},
+	}
+	dd2 := DeploymentDevice{
+		DeploymentID: d.ID,
+		DevEUI:       lorawan.EUI64{2, 2, 2, 2, 2, 2, 2, 2},
+	}
+	assert.NoError(CreateDeploymentDevice(context.Background(), ts.Tx(), &dd1))
+	assert.NoError(CreateDeploymentDevice(context.Background(), ts.Tx(), &dd2))
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+
+		groups := []DeploymentDeviceMCGroup{
+			{DeploymentID: d.ID, DevEUI: dd1.DevEUI, MCGroupID: 2, MCAddr: lorawan.DevAddr{1, 2, 3, 4}},
+			{DeploymentID: d.ID, DevEUI: dd1.DevEUI, MCGroupID: 0, MCAddr: lorawan.DevAddr{5, 6, 7, 8}},
+			{DeploymentID: d.ID, DevEUI: dd2.DevEUI, MCGroupID: 2, MCAddr: lorawan.DevAddr{1, 2, 3, 4}},
+		}
+		for i := range groups {
+			assert.NoError(CreateDeploymentDeviceMCGroup(context.Background(), ts.Tx(), &groups[i]))
+		}
+
+		t.Run("GetDeploymentDeviceMCGroups", func(t *testing.T) {
+			assert := require.New(t)
+
+			groups, err := GetDeploymentDeviceMCGroups(context.Background(), ts.Tx(), d.ID, dd1.DevEUI)
+			assert.NoError(err)
+			assert.Len(groups, 2)
+			assert.Equal(uint8(0), groups[0].MCGroupID)
+			assert.Equal(lorawan.DevAddr{5, 6, 7, 8}, groups[0].MCAddr)
+			assert.Equal(uint8(2), groups[1].MCGroupID)
+		})
+
+		t.Run("GetDeploymentMCGroupIDsInUse", func(t *testing.T) {
+			assert := require.New(t)
+
+			ids, err := GetDeploymentMCGroupIDsInUse(context.Background(), ts.Tx(), d.ID)
+			assert.NoError(err)
+			assert.Equal([]uint8{0, 2}, ids)
+		})
+
+		t.Run("DeleteDeploymentDeviceMCGroups", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(DeleteDeploymentDeviceMCGroups(context.Background(), ts.Tx(), d.ID, dd1.DevEUI))
+
+			groups, err := GetDeploymentDeviceMCGroups(context.Background(), ts.Tx(), d.ID, dd1.DevEUI)
+			assert.NoError(err)
+			assert.Len(groups, 0)
+
+			ids, err := GetDeploymentMCGroupIDsInUse(context.Background(), ts.Tx(), d.ID)
+			assert.NoError(err)
+			assert.Equal([]uint8{2}, ids)
+		})
+	})
+}
@@ -19,10 +19,13 @@ func (ts *StorageTestSuite) TestDeploymentDevice() {
 		assert := require.New(t)
 
 		dd := DeploymentDevice{
-			DeploymentID: d.ID,
-			DevEUI:       lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			DeploymentID:   d.ID,
+			DevEUI:         lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			MCRootKey:      EncryptedAES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8},
+			FragSessionCnt: 3,
 		}
 		assert.NoError(CreateDeploymentDevice(context.Background(), ts.Tx(), &dd))
+		assert.Equal(DeploymentDeviceStatePending, dd.State)
 
 		dd.CreatedAt = dd.CreatedAt.UTC()
 		dd.UpdatedAt = dd.UpdatedAt.UTC()
@@ -42,10 +45,27 @@ func (ts *StorageTestSuite) TestDeploymentDevice() {
 			assert := require.New(t)
 
 			now := time.Now().Round(time.Millisecond)
+			hwVersion := uint32(1)
+			fwVersionBefore := uint32(0x01000000)
+			fwVersionNext := uint32(0x01010000)
 			dd.MCGroupSetupCompletedAt = &now
 			dd.MCSessionCompletedAt = &now
 			dd.FragSessionSetupCompletedAt = &now
 			dd.FragStatusCompletedAt = &now
+			dd.PackageVersionCompletedAt = &now
+			dd.MCGroupStatusCompletedAt = &now
+			dd.FragDataBlockReceivedAt = &now
+			dd.UpgradeImageCompletedAt = &now
+			dd.RebootCompletedAt = &now
+			dd.FWVersionCompletedAt = &now
+			dd.HWVersion = &hwVersion
+			dd.FWVersionBefore = &fwVersionBefore
+			dd.FWVersionNext = &fwVersionNext
+			dd.FWVersionAfter = &fwVersionNext
+			dd.FragSessionCnt = 4
+			dd.ChunksCompleted = 1
+			dd.State = DeploymentDeviceStateFailed
+			dd.FailureReason = FailureReasonFragSessionStatusMissingFrag
 
 			assert.NoError(UpdateDeploymentDevice(context.Background(), ts.Tx(), &dd))
 
@@ -56,6 +76,20 @@ func (ts *StorageTestSuite) TestDeploymentDevice() {
 			assert.True(ddGet.MCSessionCompletedAt.Equal(now))
 			assert.True(ddGet.FragSessionSetupCompletedAt.Equal(now))
 			assert.True(ddGet.FragStatusCompletedAt.Equal(now))
+			assert.True(ddGet.PackageVersionCompletedAt.Equal(now))
+			assert.True(ddGet.MCGroupStatusCompletedAt.Equal(now))
+			assert.True(ddGet.FragDataBlockReceivedAt.Equal(now))
+			assert.True(ddGet.UpgradeImageCompletedAt.Equal(now))
+			assert.True(ddGet.RebootCompletedAt.Equal(now))
+			assert.True(ddGet.FWVersionCompletedAt.Equal(now))
+			assert.Equal(&hwVersion, ddGet.HWVersion)
+			assert.Equal(&fwVersionBefore, ddGet.FWVersionBefore)
+			assert.Equal(&fwVersionNext, ddGet.FWVersionNext)
+			assert.Equal(&fwVersionNext, ddGet.FWVersionAfter)
+			assert.Equal(4, ddGet.FragSessionCnt)
+			assert.Equal(1, ddGet.ChunksCompleted)
+			assert.Equal(dd.State, ddGet.State)
+			assert.Equal(dd.FailureReason, ddGet.FailureReason)
 		})
 
 		t.Run("GetDeploymentDevices", func(t *testing.T) {
@@ -65,5 +99,17 @@ func (ts *StorageTestSuite) TestDeploymentDevice() {
 			assert.NoError(err)
 			assert.Len(list, 1)
 		})
+
+		t.Run("GetLastFragSessionCnt", func(t *testing.T) {
+			assert := require.New(t)
+
+			cnt, err := GetLastFragSessionCnt(context.Background(), ts.Tx(), dd.DevEUI)
+			assert.NoError(err)
+			assert.Equal(4, cnt)
+
+			cnt, err = GetLastFragSessionCnt(context.Background(), ts.Tx(), lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1})
+			assert.NoError(err)
+			assert.Equal(0, cnt)
+		})
 	})
 }
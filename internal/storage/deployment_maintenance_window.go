@@ -0,0 +1,69 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+	log "github.com/sirupsen/logrus"
+)
+
+// DeploymentMaintenanceWindow defines a time window in which the multicast
+// session of a deployment is allowed to take place.
+type DeploymentMaintenanceWindow struct {
+	ID           int64     `db:"id"`
+	DeploymentID uuid.UUID `db:"deployment_id"`
+	StartAt      time.Time `db:"start_at"`
+	EndAt        time.Time `db:"end_at"`
+}
+
+// CreateDeploymentMaintenanceWindow creates the given DeploymentMaintenanceWindow.
+func CreateDeploymentMaintenanceWindow(ctx context.Context, db sqlx.Queryer, w *DeploymentMaintenanceWindow) error {
+	err := sqlx.Get(db, &w.ID, `
+		insert into deployment_maintenance_window (
+			deployment_id,
+			start_at,
+			end_at
+		) values (
+			$1, $2, $3)
+		returning
+			id`,
+		w.DeploymentID,
+		w.StartAt,
+		w.EndAt,
+	)
+	if err != nil {
+		return fmt.Errorf("sql create error: %w", err)
+	}
+
+	log.WithFields(log.Fields{
+		"deployment_id": w.DeploymentID,
+		"id":            w.ID,
+	}).Info("storage: deployment maintenance window created")
+
+	return nil
+}
+
+// GetDeploymentMaintenanceWindows returns the maintenance windows for the
+// given deployment ID, ordered by start time.
+func GetDeploymentMaintenanceWindows(ctx context.Context, db sqlx.Queryer, deploymentID uuid.UUID) ([]DeploymentMaintenanceWindow, error) {
+	var windows []DeploymentMaintenanceWindow
+	err := sqlx.Select(db, &windows, `
+		select
+			*
+		from
+			deployment_maintenance_window
+		where
+			deployment_id = $1
+		order by
+			start_at`,
+		deploymentID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("sql select error: %w", err)
+	}
+
+	return windows, nil
+}
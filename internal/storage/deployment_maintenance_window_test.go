@@ -0,0 +1,47 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func (ts *StorageTestSuite) TestDeploymentMaintenanceWindow() {
+	assert := require.New(ts.T())
+
+	d := Deployment{}
+	assert.NoError(CreateDeployment(context.Background(), ts.Tx(), &d))
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+
+		now := time.Now().Round(time.Millisecond).UTC()
+		w1 := DeploymentMaintenanceWindow{
+			DeploymentID: d.ID,
+			StartAt:      now.Add(time.Hour),
+			EndAt:        now.Add(2 * time.Hour),
+		}
+		w2 := DeploymentMaintenanceWindow{
+			DeploymentID: d.ID,
+			StartAt:      now,
+			EndAt:        now.Add(time.Hour),
+		}
+		assert.NoError(CreateDeploymentMaintenanceWindow(context.Background(), ts.Tx(), &w1))
+		assert.NoError(CreateDeploymentMaintenanceWindow(context.Background(), ts.Tx(), &w2))
+		assert.NotEqual(w1.ID, w2.ID)
+
+		t.Run("GetDeploymentMaintenanceWindows", func(t *testing.T) {
+			assert := require.New(t)
+
+			windows, err := GetDeploymentMaintenanceWindows(context.Background(), ts.Tx(), d.ID)
+			assert.NoError(err)
+			assert.Len(windows, 2)
+			assert.Equal(w2.ID, windows[0].ID)
+			assert.True(windows[0].StartAt.Equal(w2.StartAt))
+			assert.True(windows[0].EndAt.Equal(w2.EndAt))
+			assert.Equal(w1.ID, windows[1].ID)
+		})
+	})
+}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/gofrs/uuid"
 	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lorawan"
 )
 
 func (ts *StorageTestSuite) TestDeployment() {
@@ -16,6 +18,7 @@ func (ts *StorageTestSuite) TestDeployment() {
 		d := Deployment{}
 		assert.NoError(CreateDeployment(context.Background(), ts.Tx(), &d))
 		assert.NotEqual(uuid.Nil, d.ID)
+		assert.Equal(DeploymentStatePending, d.State)
 		assert.Less(int64(time.Now().Sub(d.CreatedAt)), int64(time.Second))
 		assert.Less(int64(time.Now().Sub(d.UpdatedAt)), int64(time.Second))
 
@@ -36,6 +39,80 @@ func (ts *StorageTestSuite) TestDeployment() {
 			assert.Nil(dGet.FragSessionSetupCompletedAt)
 			assert.Nil(dGet.EnqueueCompletedAt)
 			assert.Nil(dGet.FragStatusCompletedAt)
+			assert.Nil(dGet.DeviceCleanupCompletedAt)
+			assert.Nil(dGet.ParentDeploymentID)
+		})
+
+		t.Run("Create with payload", func(t *testing.T) {
+			assert := require.New(t)
+
+			d2 := Deployment{
+				Payload:       []byte{1, 2, 3},
+				PayloadSize:   3,
+				PayloadSHA256: []byte{4, 5, 6},
+			}
+			assert.NoError(CreateDeployment(context.Background(), ts.Tx(), &d2))
+
+			dGet, err := GetDeployment(context.Background(), ts.Tx(), d2.ID)
+			assert.NoError(err)
+			assert.Equal(d2.Payload, dGet.Payload)
+			assert.Equal(d2.PayloadSize, dGet.PayloadSize)
+			assert.Equal(d2.PayloadSHA256, dGet.PayloadSHA256)
+		})
+
+		t.Run("Create with parent", func(t *testing.T) {
+			assert := require.New(t)
+
+			d2 := Deployment{ParentDeploymentID: &d.ID}
+			assert.NoError(CreateDeployment(context.Background(), ts.Tx(), &d2))
+
+			dGet, err := GetDeployment(context.Background(), ts.Tx(), d2.ID)
+			assert.NoError(err)
+			assert.Equal(&d.ID, dGet.ParentDeploymentID)
+		})
+
+		t.Run("Create with firmware management", func(t *testing.T) {
+			assert := require.New(t)
+
+			rebootAt := time.Now().Round(time.Millisecond)
+			d2 := Deployment{
+				FirmwareManagement: true,
+				FirmwareVersion:    0x01020304,
+				RebootAt:           &rebootAt,
+				RebootCountdown:    time.Minute,
+			}
+			assert.NoError(CreateDeployment(context.Background(), ts.Tx(), &d2))
+
+			dGet, err := GetDeployment(context.Background(), ts.Tx(), d2.ID)
+			assert.NoError(err)
+			assert.True(dGet.FirmwareManagement)
+			assert.Equal(uint32(0x01020304), dGet.FirmwareVersion)
+			assert.True(dGet.RebootAt.Equal(rebootAt))
+			assert.Equal(time.Minute, dGet.RebootCountdown)
+		})
+
+		t.Run("Create with compressed and signed payload", func(t *testing.T) {
+			assert := require.New(t)
+
+			d2 := Deployment{
+				Payload:               []byte{1, 2, 3, 4},
+				PayloadSize:           4,
+				Compression:           "LZ4",
+				CompressedPayloadSize: 9,
+				PayloadSigned:         true,
+				ChunkSize:             2,
+				ChunkCount:            2,
+			}
+			assert.NoError(CreateDeployment(context.Background(), ts.Tx(), &d2))
+
+			dGet, err := GetDeployment(context.Background(), ts.Tx(), d2.ID)
+			assert.NoError(err)
+			assert.Equal("LZ4", dGet.Compression)
+			assert.Equal(4, dGet.PayloadSize)
+			assert.Equal(9, dGet.CompressedPayloadSize)
+			assert.True(dGet.PayloadSigned)
+			assert.Equal(2, dGet.ChunkSize)
+			assert.Equal(2, dGet.ChunkCount)
 		})
 
 		t.Run("Update", func(t *testing.T) {
@@ -47,6 +124,28 @@ func (ts *StorageTestSuite) TestDeployment() {
 			d.FragSessionSetupCompletedAt = &now
 			d.EnqueueCompletedAt = &now
 			d.FragStatusCompletedAt = &now
+			d.DeviceCleanupCompletedAt = &now
+			d.PackageVersionCompletedAt = &now
+			d.MCGroupStatusCompletedAt = &now
+			d.MCGroupID = 2
+			d.RepairFragCount = 5
+			d.FragRepairCompletedAt = &now
+			d.DevVersionCompletedAt = &now
+			d.UpgradeImageCompletedAt = &now
+			d.RebootCompletedAt = &now
+			d.FWVersionCompletedAt = &now
+			d.ChunksCompleted = 1
+			d.MulticastGroupID = "d9fde0d5-bcaf-4e42-8d27-417f11628905"
+			d.MCAddr = lorawan.DevAddr{1, 2, 3, 4}
+			d.MCKey = EncryptedAES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8}
+			d.SessionStartTime = &now
+			d.SessionEndTime = &now
+			d.MCGroupDevicesAddedAt = &now
+			d.MCGroupDeletedAt = &now
+			d.CompletedAt = &now
+			d.CancelledAt = &now
+			d.State = DeploymentStateFailed
+			d.ErrorMessage = "create multicast-group error"
 
 			assert.NoError(UpdateDeployment(context.Background(), ts.Tx(), &d))
 
@@ -58,6 +157,159 @@ func (ts *StorageTestSuite) TestDeployment() {
 			assert.True(dGet.FragSessionSetupCompletedAt.Equal(now))
 			assert.True(dGet.EnqueueCompletedAt.Equal(now))
 			assert.True(dGet.FragStatusCompletedAt.Equal(now))
+			assert.True(dGet.DeviceCleanupCompletedAt.Equal(now))
+			assert.True(dGet.PackageVersionCompletedAt.Equal(now))
+			assert.True(dGet.MCGroupStatusCompletedAt.Equal(now))
+			assert.Equal(uint8(2), dGet.MCGroupID)
+			assert.Equal(5, dGet.RepairFragCount)
+			assert.True(dGet.FragRepairCompletedAt.Equal(now))
+			assert.True(dGet.DevVersionCompletedAt.Equal(now))
+			assert.True(dGet.UpgradeImageCompletedAt.Equal(now))
+			assert.True(dGet.RebootCompletedAt.Equal(now))
+			assert.True(dGet.FWVersionCompletedAt.Equal(now))
+			assert.Equal(1, dGet.ChunksCompleted)
+			assert.Equal(d.MulticastGroupID, dGet.MulticastGroupID)
+			assert.Equal(d.MCAddr, dGet.MCAddr)
+			assert.Equal(d.MCKey, dGet.MCKey)
+			assert.True(dGet.SessionStartTime.Equal(now))
+			assert.True(dGet.SessionEndTime.Equal(now))
+			assert.True(dGet.MCGroupDevicesAddedAt.Equal(now))
+			assert.True(dGet.MCGroupDeletedAt.Equal(now))
+			assert.True(dGet.CompletedAt.Equal(now))
+			assert.True(dGet.CancelledAt.Equal(now))
+			assert.Equal(d.State, dGet.State)
+			assert.Equal(d.ErrorMessage, dGet.ErrorMessage)
 		})
 	})
+
+	ts.T().Run("GetUnfinishedDeploymentIDs", func(t *testing.T) {
+		assert := require.New(t)
+
+		d1 := Deployment{}
+		d2 := Deployment{State: DeploymentStateRunning}
+		d3 := Deployment{State: DeploymentStateCompleted}
+		d4 := Deployment{State: DeploymentStateFailed}
+		d5 := Deployment{State: DeploymentStateCancelled}
+		for _, d := range []*Deployment{&d1, &d2, &d3, &d4, &d5} {
+			assert.NoError(CreateDeployment(context.Background(), ts.Tx(), d))
+		}
+
+		ids, err := GetUnfinishedDeploymentIDs(context.Background(), ts.Tx())
+		assert.NoError(err)
+		assert.NotContains(ids, d1.ID)
+		assert.Contains(ids, d2.ID)
+		assert.NotContains(ids, d3.ID)
+		assert.NotContains(ids, d4.ID)
+		assert.NotContains(ids, d5.ID)
+	})
+}
+
+func (ts *StorageTestSuite) TestGetDueDeploymentIDs() {
+	assert := require.New(ts.T())
+
+	now := time.Now()
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Minute)
+
+	d1 := Deployment{StartAt: &past}
+	d2 := Deployment{StartAt: &future}
+	d3 := Deployment{}
+	d4 := Deployment{StartAt: &past, State: DeploymentStateRunning}
+	for _, d := range []*Deployment{&d1, &d2, &d3, &d4} {
+		assert.NoError(CreateDeployment(context.Background(), ts.Tx(), d))
+	}
+
+	ids, err := GetDueDeploymentIDs(context.Background(), ts.Tx(), now)
+	assert.NoError(err)
+	assert.Equal([]uuid.UUID{d1.ID}, ids)
+}
+
+func (ts *StorageTestSuite) TestGetDeployments() {
+	assert := require.New(ts.T())
+
+	now := time.Now()
+	d1 := Deployment{ApplicationID: "app-1", State: DeploymentStateRunning}
+	d2 := Deployment{ApplicationID: "app-1", State: DeploymentStateCompleted}
+	d3 := Deployment{ApplicationID: "app-2", State: DeploymentStateCancelled}
+	for _, d := range []*Deployment{&d1, &d2, &d3} {
+		// make sure the created_at timestamps are unique
+		time.Sleep(2 * time.Millisecond)
+		assert.NoError(CreateDeployment(context.Background(), ts.Tx(), d))
+	}
+
+	devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+	assert.NoError(CreateDeploymentDevice(context.Background(), ts.Tx(), &DeploymentDevice{
+		DeploymentID: d2.ID,
+		DevEUI:       devEUI,
+	}))
+
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		filters DeploymentFilters
+		ids     []uuid.UUID
+	}{
+		{
+			name: "no filters",
+			ids:  []uuid.UUID{d3.ID, d2.ID, d1.ID},
+		},
+		{
+			name:    "application id",
+			filters: DeploymentFilters{ApplicationID: "app-1"},
+			ids:     []uuid.UUID{d2.ID, d1.ID},
+		},
+		{
+			name:    "states",
+			filters: DeploymentFilters{States: []DeploymentState{DeploymentStateRunning, DeploymentStateCancelled}},
+			ids:     []uuid.UUID{d3.ID, d1.ID},
+		},
+		{
+			name:    "created range",
+			filters: DeploymentFilters{CreatedAfter: &past, CreatedBefore: &future},
+			ids:     []uuid.UUID{d3.ID, d2.ID, d1.ID},
+		},
+		{
+			name:    "created after",
+			filters: DeploymentFilters{CreatedAfter: &future},
+		},
+		{
+			name:    "unknown state",
+			filters: DeploymentFilters{States: []DeploymentState{"FOO"}},
+		},
+		{
+			name:    "dev eui",
+			filters: DeploymentFilters{DevEUI: &devEUI},
+			ids:     []uuid.UUID{d2.ID},
+		},
+		{
+			name:    "limit and offset",
+			filters: DeploymentFilters{Limit: 1, Offset: 1},
+			ids:     []uuid.UUID{d2.ID},
+		},
+	}
+
+	for _, tst := range tests {
+		ts.T().Run(tst.name, func(t *testing.T) {
+			assert := require.New(t)
+
+			items, err := GetDeployments(context.Background(), ts.Tx(), tst.filters)
+			assert.NoError(err)
+
+			var ids []uuid.UUID
+			for _, item := range items {
+				ids = append(ids, item.ID)
+			}
+			assert.Equal(tst.ids, ids)
+
+			count, err := GetDeploymentCount(context.Background(), ts.Tx(), tst.filters)
+			assert.NoError(err)
+			if tst.filters.Limit == 0 {
+				assert.Equal(len(tst.ids), count)
+			} else {
+				assert.Equal(3, count)
+			}
+		})
+	}
 }
@@ -0,0 +1,96 @@
+package storage
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"database/sql/driver"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/brocaar/lorawan"
+)
+
+// aead holds the cipher used for encrypting the keys stored in the
+// database. When nil, the keys are stored unencrypted.
+var aead cipher.AEAD
+
+// setEncryptionKey sets the (HEX encoded) AES key used for encrypting the
+// keys stored in the database. An empty key disables the encryption.
+func setEncryptionKey(key string) error {
+	if key == "" {
+		aead = nil
+		return nil
+	}
+
+	b, err := hex.DecodeString(key)
+	if err != nil {
+		return fmt.Errorf("decode encryption key error: %w", err)
+	}
+
+	block, err := aes.NewCipher(b)
+	if err != nil {
+		return fmt.Errorf("new cipher error: %w", err)
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return fmt.Errorf("new gcm error: %w", err)
+	}
+
+	aead = gcm
+	return nil
+}
+
+// EncryptedAES128Key defines an AES128Key which is stored encrypted
+// (AES-GCM) using the configured encryption key. Keys that were stored
+// unencrypted (e.g. before the encryption key was configured) can still be
+// read.
+type EncryptedAES128Key lorawan.AES128Key
+
+// Value implements driver.Valuer.
+func (k EncryptedAES128Key) Value() (driver.Value, error) {
+	if aead == nil {
+		return k[:], nil
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("read random bytes error: %w", err)
+	}
+
+	return aead.Seal(nonce, nonce, k[:], nil), nil
+}
+
+// Scan implements sql.Scanner.
+func (k *EncryptedAES128Key) Scan(src interface{}) error {
+	b, ok := src.([]byte)
+	if !ok {
+		return errors.New("storage: []byte type expected")
+	}
+
+	// unencrypted key
+	if len(b) == len(k) {
+		copy(k[:], b)
+		return nil
+	}
+
+	if aead == nil {
+		return errors.New("storage: key is encrypted, but no encryption key is configured")
+	}
+	if len(b) < aead.NonceSize() {
+		return errors.New("storage: invalid encrypted key")
+	}
+
+	plain, err := aead.Open(nil, b[:aead.NonceSize()], b[aead.NonceSize():], nil)
+	if err != nil {
+		return fmt.Errorf("storage: decrypt key error: %w", err)
+	}
+	if len(plain) != len(k) {
+		return errors.New("storage: invalid encrypted key")
+	}
+
+	copy(k[:], plain)
+	return nil
+}
@@ -0,0 +1,68 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptedAES128Key(t *testing.T) {
+	key := EncryptedAES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8}
+	defer setEncryptionKey("")
+
+	t.Run("Unencrypted", func(t *testing.T) {
+		assert := require.New(t)
+		assert.NoError(setEncryptionKey(""))
+
+		v, err := key.Value()
+		assert.NoError(err)
+		assert.Equal(key[:], v)
+
+		var out EncryptedAES128Key
+		assert.NoError(out.Scan(v))
+		assert.Equal(key, out)
+	})
+
+	t.Run("Encrypted", func(t *testing.T) {
+		assert := require.New(t)
+		assert.NoError(setEncryptionKey("000102030405060708090a0b0c0d0e0f"))
+
+		v, err := key.Value()
+		assert.NoError(err)
+		assert.NotContains(string(v.([]byte)), string(key[:]))
+
+		var out EncryptedAES128Key
+		assert.NoError(out.Scan(v))
+		assert.Equal(key, out)
+
+		t.Run("Unencrypted key remains readable", func(t *testing.T) {
+			assert := require.New(t)
+
+			var out EncryptedAES128Key
+			assert.NoError(out.Scan(key[:]))
+			assert.Equal(key, out)
+		})
+
+		t.Run("Wrong encryption key", func(t *testing.T) {
+			assert := require.New(t)
+			assert.NoError(setEncryptionKey("0f0e0d0c0b0a09080706050403020100"))
+
+			var out EncryptedAES128Key
+			assert.Error(out.Scan(v))
+		})
+
+		t.Run("No encryption key", func(t *testing.T) {
+			assert := require.New(t)
+			assert.NoError(setEncryptionKey(""))
+
+			var out EncryptedAES128Key
+			assert.Error(out.Scan(v))
+		})
+	})
+
+	t.Run("Invalid encryption key", func(t *testing.T) {
+		assert := require.New(t)
+		assert.Error(setEncryptionKey("0001"))
+		assert.Error(setEncryptionKey("zz"))
+	})
+}
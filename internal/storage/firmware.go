@@ -0,0 +1,201 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq/hstore"
+	log "github.com/sirupsen/logrus"
+)
+
+// Firmware represents a versioned firmware image which can be used as the
+// payload of a deployment.
+type Firmware struct {
+	ID            uuid.UUID     `db:"id"`
+	CreatedAt     time.Time     `db:"created_at"`
+	UpdatedAt     time.Time     `db:"updated_at"`
+	Version       string        `db:"version"`
+	Hardware      string        `db:"hardware"`
+	Payload       []byte        `db:"payload"`
+	PayloadSize   int           `db:"payload_size"`
+	PayloadSHA256 []byte        `db:"payload_sha256"`
+	Metadata      hstore.Hstore `db:"metadata"`
+	Signature     []byte        `db:"signature"`
+}
+
+// FirmwareListItem defines the Firmware as returned by GetFirmwares.
+type FirmwareListItem struct {
+	ID            uuid.UUID `db:"id"`
+	CreatedAt     time.Time `db:"created_at"`
+	UpdatedAt     time.Time `db:"updated_at"`
+	Version       string    `db:"version"`
+	Hardware      string    `db:"hardware"`
+	PayloadSize   int       `db:"payload_size"`
+	PayloadSHA256 []byte    `db:"payload_sha256"`
+}
+
+// FirmwareFilters provides filters for filtering firmware.
+type FirmwareFilters struct {
+	Hardware string `db:"hardware"`
+
+	// Limit and Offset are added for convenience so that this struct can
+	// be given as the arguments. A Limit of 0 returns all items.
+	Limit  int `db:"limit"`
+	Offset int `db:"offset"`
+}
+
+// SQL returns the SQL filters.
+func (f FirmwareFilters) SQL() string {
+	if f.Hardware != "" {
+		return "where f.hardware = :hardware"
+	}
+
+	return ""
+}
+
+// CreateFirmware creates the given Firmware.
+func CreateFirmware(ctx context.Context, db sqlx.Execer, f *Firmware) error {
+	if f.ID == uuid.Nil {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return fmt.Errorf("new uuid error: %w", err)
+		}
+
+		f.ID = id
+	}
+
+	now := time.Now().Round(time.Millisecond)
+	f.CreatedAt = now
+	f.UpdatedAt = now
+
+	_, err := db.Exec(`
+		insert into firmware (
+			id,
+			created_at,
+			updated_at,
+			version,
+			hardware,
+			payload,
+			payload_size,
+			payload_sha256,
+			metadata,
+			signature
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		f.ID,
+		f.CreatedAt,
+		f.UpdatedAt,
+		f.Version,
+		f.Hardware,
+		f.Payload,
+		f.PayloadSize,
+		f.PayloadSHA256,
+		f.Metadata,
+		f.Signature,
+	)
+	if err != nil {
+		return fmt.Errorf("sql exec error: %w", err)
+	}
+
+	log.WithFields(log.Fields{
+		"id":       f.ID,
+		"version":  f.Version,
+		"hardware": f.Hardware,
+	}).Info("storage: firmware created")
+
+	return nil
+}
+
+// GetFirmware returns the Firmware given an ID.
+func GetFirmware(ctx context.Context, db sqlx.Queryer, id uuid.UUID) (Firmware, error) {
+	var f Firmware
+	err := sqlx.Get(db, &f, "select * from firmware where id = $1", id)
+	if err != nil {
+		return f, fmt.Errorf("sql select error: %w", err)
+	}
+
+	return f, nil
+}
+
+// DeleteFirmware deletes the Firmware with the given ID. Deployments that
+// were created using the firmware keep their (copy of the) payload.
+func DeleteFirmware(ctx context.Context, db sqlx.Execer, id uuid.UUID) error {
+	res, err := db.Exec("delete from firmware where id = $1", id)
+	if err != nil {
+		return fmt.Errorf("sql delete error: %w", err)
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("get rows affected error: %w", err)
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"id": id,
+	}).Info("storage: firmware deleted")
+
+	return nil
+}
+
+// GetFirmwareCount returns the number of firmware images matching the given
+// filters.
+func GetFirmwareCount(ctx context.Context, db sqlx.Queryer, filters FirmwareFilters) (int, error) {
+	query, args, err := sqlx.BindNamed(sqlx.DOLLAR, `
+		select
+			count(*)
+		from
+			firmware f
+		`+filters.SQL(), filters)
+	if err != nil {
+		return 0, fmt.Errorf("named query error: %w", err)
+	}
+
+	var count int
+	if err := sqlx.Get(db, &count, query, args...); err != nil {
+		return 0, fmt.Errorf("sql select error: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetFirmwares returns a slice of firmware images matching the given
+// filters, ordered by creation time (most recent first). The payload is not
+// included.
+func GetFirmwares(ctx context.Context, db sqlx.Queryer, filters FirmwareFilters) ([]FirmwareListItem, error) {
+	limit := ""
+	if filters.Limit != 0 {
+		limit = "limit :limit"
+	}
+
+	query, args, err := sqlx.BindNamed(sqlx.DOLLAR, `
+		select
+			f.id,
+			f.created_at,
+			f.updated_at,
+			f.version,
+			f.hardware,
+			f.payload_size,
+			f.payload_sha256
+		from
+			firmware f
+		`+filters.SQL()+`
+		order by
+			f.created_at desc,
+			f.id
+		`+limit+`
+		offset :offset`, filters)
+	if err != nil {
+		return nil, fmt.Errorf("named query error: %w", err)
+	}
+
+	var items []FirmwareListItem
+	if err := sqlx.Select(db, &items, query, args...); err != nil {
+		return nil, fmt.Errorf("sql select error: %w", err)
+	}
+
+	return items, nil
+}
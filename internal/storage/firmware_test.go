@@ -0,0 +1,104 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq/hstore"
+	"github.com/stretchr/testify/require"
+)
+
+func (ts *StorageTestSuite) TestFirmware() {
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+
+		f := Firmware{
+			Version:       "1.2.0",
+			Hardware:      "rev-a",
+			Payload:       []byte{1, 2, 3},
+			PayloadSize:   3,
+			PayloadSHA256: []byte{4, 5, 6},
+			Signature:     []byte{7, 8, 9},
+			Metadata: hstore.Hstore{
+				Map: map[string]sql.NullString{
+					"release_notes": sql.NullString{Valid: true, String: "bugfixes"},
+				},
+			},
+		}
+		assert.NoError(CreateFirmware(context.Background(), ts.Tx(), &f))
+		assert.NotEqual(uuid.Nil, f.ID)
+
+		f.CreatedAt = f.CreatedAt.UTC()
+		f.UpdatedAt = f.UpdatedAt.UTC()
+
+		t.Run("Get", func(t *testing.T) {
+			assert := require.New(t)
+
+			fGet, err := GetFirmware(context.Background(), ts.Tx(), f.ID)
+			assert.NoError(err)
+
+			fGet.CreatedAt = fGet.CreatedAt.UTC()
+			fGet.UpdatedAt = fGet.UpdatedAt.UTC()
+			assert.Equal(f, fGet)
+		})
+
+		t.Run("GetFirmwares", func(t *testing.T) {
+			assert := require.New(t)
+
+			f2 := Firmware{
+				Version:       "1.2.0",
+				Hardware:      "rev-b",
+				Payload:       []byte{1, 2, 3},
+				PayloadSize:   3,
+				PayloadSHA256: []byte{4, 5, 6},
+			}
+			assert.NoError(CreateFirmware(context.Background(), ts.Tx(), &f2))
+
+			count, err := GetFirmwareCount(context.Background(), ts.Tx(), FirmwareFilters{})
+			assert.NoError(err)
+			assert.Equal(2, count)
+
+			count, err = GetFirmwareCount(context.Background(), ts.Tx(), FirmwareFilters{Hardware: "rev-a"})
+			assert.NoError(err)
+			assert.Equal(1, count)
+
+			items, err := GetFirmwares(context.Background(), ts.Tx(), FirmwareFilters{Hardware: "rev-a"})
+			assert.NoError(err)
+			assert.Len(items, 1)
+			assert.Equal(f.ID, items[0].ID)
+			assert.Equal("1.2.0", items[0].Version)
+			assert.Equal(3, items[0].PayloadSize)
+
+			items, err = GetFirmwares(context.Background(), ts.Tx(), FirmwareFilters{Limit: 1, Offset: 1})
+			assert.NoError(err)
+			assert.Len(items, 1)
+		})
+
+		t.Run("Duplicate version", func(t *testing.T) {
+			assert := require.New(t)
+
+			f2 := f
+			f2.ID = uuid.Nil
+			assert.Error(CreateFirmware(context.Background(), ts.Tx(), &f2))
+		})
+
+		t.Run("Delete", func(t *testing.T) {
+			assert := require.New(t)
+
+			d := Deployment{FirmwareID: &f.ID}
+			assert.NoError(CreateDeployment(context.Background(), ts.Tx(), &d))
+
+			assert.NoError(DeleteFirmware(context.Background(), ts.Tx(), f.ID))
+			assert.Equal(ErrDoesNotExist, DeleteFirmware(context.Background(), ts.Tx(), f.ID))
+
+			_, err := GetFirmware(context.Background(), ts.Tx(), f.ID)
+			assert.Error(err)
+
+			dGet, err := GetDeployment(context.Background(), ts.Tx(), d.ID)
+			assert.NoError(err)
+			assert.Nil(dGet.FirmwareID)
+		})
+	})
+}
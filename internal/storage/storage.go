@@ -32,6 +32,13 @@ func Setup(conf *config.Config) error {
 	if err != nil {
 		return fmt.Errorf("open postgresql connection error: %w", err)
 	}
+	if err := setEncryptionKey(conf.PostgreSQL.EncryptionKey); err != nil {
+		return err
+	}
+	if conf.PostgreSQL.EncryptionKey == "" {
+		log.Warning("storage: no encryption key configured, multicast keys are stored unencrypted")
+	}
+
 	d.SetMaxOpenConns(conf.PostgreSQL.MaxOpenConnections)
 	d.SetMaxIdleConns(conf.PostgreSQL.MaxIdleConnections)
 	for {
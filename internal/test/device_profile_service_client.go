@@ -0,0 +1,298 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/chirpstack/chirpstack/api/go/v4/api (interfaces: DeviceProfileServiceClient)
+
+// Package test is a generated GoMock package.
+package test
+
+import (
+	context "context"
+	reflect "reflect"
+
+	api "github.com/chirpstack/chirpstack/api/go/v4/api"
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// MockDeviceProfileServiceClient is a mock of DeviceProfileServiceClient interface.
+type MockDeviceProfileServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeviceProfileServiceClientMockRecorder
+}
+
+// MockDeviceProfileServiceClientMockRecorder is the mock recorder for MockDeviceProfileServiceClient.
+type MockDeviceProfileServiceClientMockRecorder struct {
+	mock *MockDeviceProfileServiceClient
+}
+
+// NewMockDeviceProfileServiceClient creates a new mock instance.
+func NewMockDeviceProfileServiceClient(ctrl *gomock.Controller) *MockDeviceProfileServiceClient {
+	mock := &MockDeviceProfileServiceClient{ctrl: ctrl}
+	mock.recorder = &MockDeviceProfileServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeviceProfileServiceClient) EXPECT() *MockDeviceProfileServiceClientMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDeviceProfileServiceClient) Create(arg0 context.Context, arg1 *api.CreateDeviceProfileRequest, arg2 ...grpc.CallOption) (*api.CreateDeviceProfileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Create", varargs...)
+	ret0, _ := ret[0].(*api.CreateDeviceProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDeviceProfileServiceClientMockRecorder) Create(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDeviceProfileServiceClient)(nil).Create), varargs...)
+}
+
+// Delete mocks base method.
+func (m *MockDeviceProfileServiceClient) Delete(arg0 context.Context, arg1 *api.DeleteDeviceProfileRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Delete", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDeviceProfileServiceClientMockRecorder) Delete(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDeviceProfileServiceClient)(nil).Delete), varargs...)
+}
+
+// DeleteDevice mocks base method.
+func (m *MockDeviceProfileServiceClient) DeleteDevice(arg0 context.Context, arg1 *api.DeleteDeviceProfileDeviceRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteDevice", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDevice indicates an expected call of DeleteDevice.
+func (mr *MockDeviceProfileServiceClientMockRecorder) DeleteDevice(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDevice", reflect.TypeOf((*MockDeviceProfileServiceClient)(nil).DeleteDevice), varargs...)
+}
+
+// DeleteVendor mocks base method.
+func (m *MockDeviceProfileServiceClient) DeleteVendor(arg0 context.Context, arg1 *api.DeleteDeviceProfileVendorRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteVendor", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVendor indicates an expected call of DeleteVendor.
+func (mr *MockDeviceProfileServiceClientMockRecorder) DeleteVendor(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVendor", reflect.TypeOf((*MockDeviceProfileServiceClient)(nil).DeleteVendor), varargs...)
+}
+
+// Get mocks base method.
+func (m *MockDeviceProfileServiceClient) Get(arg0 context.Context, arg1 *api.GetDeviceProfileRequest, arg2 ...grpc.CallOption) (*api.GetDeviceProfileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Get", varargs...)
+	ret0, _ := ret[0].(*api.GetDeviceProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDeviceProfileServiceClientMockRecorder) Get(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDeviceProfileServiceClient)(nil).Get), varargs...)
+}
+
+// GetByProfileId mocks base method.
+func (m *MockDeviceProfileServiceClient) GetByProfileId(arg0 context.Context, arg1 *api.GetDeviceProfileByProfileIdRequest, arg2 ...grpc.CallOption) (*api.GetDeviceProfileByProfileIdResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetByProfileId", varargs...)
+	ret0, _ := ret[0].(*api.GetDeviceProfileByProfileIdResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByProfileId indicates an expected call of GetByProfileId.
+func (mr *MockDeviceProfileServiceClientMockRecorder) GetByProfileId(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByProfileId", reflect.TypeOf((*MockDeviceProfileServiceClient)(nil).GetByProfileId), varargs...)
+}
+
+// GetDevice mocks base method.
+func (m *MockDeviceProfileServiceClient) GetDevice(arg0 context.Context, arg1 *api.GetDeviceProfileDeviceRequest, arg2 ...grpc.CallOption) (*api.GetDeviceProfileDeviceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDevice", varargs...)
+	ret0, _ := ret[0].(*api.GetDeviceProfileDeviceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDevice indicates an expected call of GetDevice.
+func (mr *MockDeviceProfileServiceClientMockRecorder) GetDevice(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevice", reflect.TypeOf((*MockDeviceProfileServiceClient)(nil).GetDevice), varargs...)
+}
+
+// GetVendor mocks base method.
+func (m *MockDeviceProfileServiceClient) GetVendor(arg0 context.Context, arg1 *api.GetDeviceProfileVendorRequest, arg2 ...grpc.CallOption) (*api.GetDeviceProfileVendorResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVendor", varargs...)
+	ret0, _ := ret[0].(*api.GetDeviceProfileVendorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVendor indicates an expected call of GetVendor.
+func (mr *MockDeviceProfileServiceClientMockRecorder) GetVendor(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVendor", reflect.TypeOf((*MockDeviceProfileServiceClient)(nil).GetVendor), varargs...)
+}
+
+// List mocks base method.
+func (m *MockDeviceProfileServiceClient) List(arg0 context.Context, arg1 *api.ListDeviceProfilesRequest, arg2 ...grpc.CallOption) (*api.ListDeviceProfilesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "List", varargs...)
+	ret0, _ := ret[0].(*api.ListDeviceProfilesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockDeviceProfileServiceClientMockRecorder) List(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockDeviceProfileServiceClient)(nil).List), varargs...)
+}
+
+// ListAdrAlgorithms mocks base method.
+func (m *MockDeviceProfileServiceClient) ListAdrAlgorithms(arg0 context.Context, arg1 *emptypb.Empty, arg2 ...grpc.CallOption) (*api.ListDeviceProfileAdrAlgorithmsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAdrAlgorithms", varargs...)
+	ret0, _ := ret[0].(*api.ListDeviceProfileAdrAlgorithmsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAdrAlgorithms indicates an expected call of ListAdrAlgorithms.
+func (mr *MockDeviceProfileServiceClientMockRecorder) ListAdrAlgorithms(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdrAlgorithms", reflect.TypeOf((*MockDeviceProfileServiceClient)(nil).ListAdrAlgorithms), varargs...)
+}
+
+// ListDevices mocks base method.
+func (m *MockDeviceProfileServiceClient) ListDevices(arg0 context.Context, arg1 *api.ListDeviceProfileDevicesRequest, arg2 ...grpc.CallOption) (*api.ListDeviceProfileDevicesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDevices", varargs...)
+	ret0, _ := ret[0].(*api.ListDeviceProfileDevicesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDevices indicates an expected call of ListDevices.
+func (mr *MockDeviceProfileServiceClientMockRecorder) ListDevices(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDevices", reflect.TypeOf((*MockDeviceProfileServiceClient)(nil).ListDevices), varargs...)
+}
+
+// ListVendors mocks base method.
+func (m *MockDeviceProfileServiceClient) ListVendors(arg0 context.Context, arg1 *api.ListDeviceProfileVendorsRequest, arg2 ...grpc.CallOption) (*api.ListDeviceProfileVendorsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVendors", varargs...)
+	ret0, _ := ret[0].(*api.ListDeviceProfileVendorsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVendors indicates an expected call of ListVendors.
+func (mr *MockDeviceProfileServiceClientMockRecorder) ListVendors(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVendors", reflect.TypeOf((*MockDeviceProfileServiceClient)(nil).ListVendors), varargs...)
+}
+
+// Update mocks base method.
+func (m *MockDeviceProfileServiceClient) Update(arg0 context.Context, arg1 *api.UpdateDeviceProfileRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Update", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDeviceProfileServiceClientMockRecorder) Update(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDeviceProfileServiceClient)(nil).Update), varargs...)
+}
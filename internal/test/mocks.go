@@ -1,3 +1,5 @@
 package test
 
 //go:generate mockgen -package test -destination device_service_client.go github.com/chirpstack/chirpstack/api/go/v4/api DeviceServiceClient
+//go:generate mockgen -package test -destination device_profile_service_client.go github.com/chirpstack/chirpstack/api/go/v4/api DeviceProfileServiceClient
+//go:generate mockgen -package test -destination multicast_group_service_client.go github.com/chirpstack/chirpstack/api/go/v4/api MulticastGroupServiceClient
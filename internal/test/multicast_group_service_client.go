@@ -0,0 +1,278 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/chirpstack/chirpstack/api/go/v4/api (interfaces: MulticastGroupServiceClient)
+
+// Package test is a generated GoMock package.
+package test
+
+import (
+	context "context"
+	reflect "reflect"
+
+	api "github.com/chirpstack/chirpstack/api/go/v4/api"
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// MockMulticastGroupServiceClient is a mock of MulticastGroupServiceClient interface.
+type MockMulticastGroupServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockMulticastGroupServiceClientMockRecorder
+}
+
+// MockMulticastGroupServiceClientMockRecorder is the mock recorder for MockMulticastGroupServiceClient.
+type MockMulticastGroupServiceClientMockRecorder struct {
+	mock *MockMulticastGroupServiceClient
+}
+
+// NewMockMulticastGroupServiceClient creates a new mock instance.
+func NewMockMulticastGroupServiceClient(ctrl *gomock.Controller) *MockMulticastGroupServiceClient {
+	mock := &MockMulticastGroupServiceClient{ctrl: ctrl}
+	mock.recorder = &MockMulticastGroupServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMulticastGroupServiceClient) EXPECT() *MockMulticastGroupServiceClientMockRecorder {
+	return m.recorder
+}
+
+// AddDevice mocks base method.
+func (m *MockMulticastGroupServiceClient) AddDevice(arg0 context.Context, arg1 *api.AddDeviceToMulticastGroupRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddDevice", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDevice indicates an expected call of AddDevice.
+func (mr *MockMulticastGroupServiceClientMockRecorder) AddDevice(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDevice", reflect.TypeOf((*MockMulticastGroupServiceClient)(nil).AddDevice), varargs...)
+}
+
+// AddGateway mocks base method.
+func (m *MockMulticastGroupServiceClient) AddGateway(arg0 context.Context, arg1 *api.AddGatewayToMulticastGroupRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddGateway", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddGateway indicates an expected call of AddGateway.
+func (mr *MockMulticastGroupServiceClientMockRecorder) AddGateway(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGateway", reflect.TypeOf((*MockMulticastGroupServiceClient)(nil).AddGateway), varargs...)
+}
+
+// Create mocks base method.
+func (m *MockMulticastGroupServiceClient) Create(arg0 context.Context, arg1 *api.CreateMulticastGroupRequest, arg2 ...grpc.CallOption) (*api.CreateMulticastGroupResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Create", varargs...)
+	ret0, _ := ret[0].(*api.CreateMulticastGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockMulticastGroupServiceClientMockRecorder) Create(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockMulticastGroupServiceClient)(nil).Create), varargs...)
+}
+
+// Delete mocks base method.
+func (m *MockMulticastGroupServiceClient) Delete(arg0 context.Context, arg1 *api.DeleteMulticastGroupRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Delete", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockMulticastGroupServiceClientMockRecorder) Delete(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMulticastGroupServiceClient)(nil).Delete), varargs...)
+}
+
+// Enqueue mocks base method.
+func (m *MockMulticastGroupServiceClient) Enqueue(arg0 context.Context, arg1 *api.EnqueueMulticastGroupQueueItemRequest, arg2 ...grpc.CallOption) (*api.EnqueueMulticastGroupQueueItemResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Enqueue", varargs...)
+	ret0, _ := ret[0].(*api.EnqueueMulticastGroupQueueItemResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockMulticastGroupServiceClientMockRecorder) Enqueue(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockMulticastGroupServiceClient)(nil).Enqueue), varargs...)
+}
+
+// FlushQueue mocks base method.
+func (m *MockMulticastGroupServiceClient) FlushQueue(arg0 context.Context, arg1 *api.FlushMulticastGroupQueueRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FlushQueue", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FlushQueue indicates an expected call of FlushQueue.
+func (mr *MockMulticastGroupServiceClientMockRecorder) FlushQueue(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlushQueue", reflect.TypeOf((*MockMulticastGroupServiceClient)(nil).FlushQueue), varargs...)
+}
+
+// Get mocks base method.
+func (m *MockMulticastGroupServiceClient) Get(arg0 context.Context, arg1 *api.GetMulticastGroupRequest, arg2 ...grpc.CallOption) (*api.GetMulticastGroupResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Get", varargs...)
+	ret0, _ := ret[0].(*api.GetMulticastGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockMulticastGroupServiceClientMockRecorder) Get(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockMulticastGroupServiceClient)(nil).Get), varargs...)
+}
+
+// List mocks base method.
+func (m *MockMulticastGroupServiceClient) List(arg0 context.Context, arg1 *api.ListMulticastGroupsRequest, arg2 ...grpc.CallOption) (*api.ListMulticastGroupsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "List", varargs...)
+	ret0, _ := ret[0].(*api.ListMulticastGroupsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockMulticastGroupServiceClientMockRecorder) List(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockMulticastGroupServiceClient)(nil).List), varargs...)
+}
+
+// ListQueue mocks base method.
+func (m *MockMulticastGroupServiceClient) ListQueue(arg0 context.Context, arg1 *api.ListMulticastGroupQueueRequest, arg2 ...grpc.CallOption) (*api.ListMulticastGroupQueueResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListQueue", varargs...)
+	ret0, _ := ret[0].(*api.ListMulticastGroupQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQueue indicates an expected call of ListQueue.
+func (mr *MockMulticastGroupServiceClientMockRecorder) ListQueue(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueue", reflect.TypeOf((*MockMulticastGroupServiceClient)(nil).ListQueue), varargs...)
+}
+
+// RemoveDevice mocks base method.
+func (m *MockMulticastGroupServiceClient) RemoveDevice(arg0 context.Context, arg1 *api.RemoveDeviceFromMulticastGroupRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveDevice", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveDevice indicates an expected call of RemoveDevice.
+func (mr *MockMulticastGroupServiceClientMockRecorder) RemoveDevice(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDevice", reflect.TypeOf((*MockMulticastGroupServiceClient)(nil).RemoveDevice), varargs...)
+}
+
+// RemoveGateway mocks base method.
+func (m *MockMulticastGroupServiceClient) RemoveGateway(arg0 context.Context, arg1 *api.RemoveGatewayFromMulticastGroupRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveGateway", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveGateway indicates an expected call of RemoveGateway.
+func (mr *MockMulticastGroupServiceClientMockRecorder) RemoveGateway(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGateway", reflect.TypeOf((*MockMulticastGroupServiceClient)(nil).RemoveGateway), varargs...)
+}
+
+// Update mocks base method.
+func (m *MockMulticastGroupServiceClient) Update(arg0 context.Context, arg1 *api.UpdateMulticastGroupRequest, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Update", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockMulticastGroupServiceClientMockRecorder) Update(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockMulticastGroupServiceClient)(nil).Update), varargs...)
+}
@@ -0,0 +1,32 @@
+alter table deployment_device
+    drop column mc_root_key;
+
+drop index idx_deployment_completed_at;
+
+alter table deployment
+    drop column application_id,
+    drop column multicast_group_type,
+    drop column multicast_dr,
+    drop column multicast_ping_slot_periodicity,
+    drop column multicast_frequency,
+    drop column mc_group_id,
+    drop column multicast_timeout,
+    drop column multicast_region,
+    drop column unicast_timeout,
+    drop column unicast_attempt_count,
+    drop column frag_size,
+    drop column payload,
+    drop column redundancy,
+    drop column fragmentation_session_index,
+    drop column fragmentation_matrix,
+    drop column block_ack_delay,
+    drop column descriptor,
+    drop column request_fragmentation_session_status,
+    drop column multicast_group_id,
+    drop column mc_addr,
+    drop column mc_key,
+    drop column session_start_time,
+    drop column session_end_time,
+    drop column mc_group_devices_added_at,
+    drop column mc_group_deleted_at,
+    drop column completed_at;
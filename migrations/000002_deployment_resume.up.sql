@@ -0,0 +1,36 @@
+alter table deployment
+    add column application_id varchar(36) not null default '',
+    add column multicast_group_type varchar(10) not null default '',
+    add column multicast_dr smallint not null default 0,
+    add column multicast_ping_slot_periodicity smallint not null default 0,
+    add column multicast_frequency bigint not null default 0,
+    add column mc_group_id smallint not null default 0,
+    add column multicast_timeout smallint not null default 0,
+    add column multicast_region varchar(20) not null default '',
+    add column unicast_timeout bigint not null default 0,
+    add column unicast_attempt_count integer not null default 0,
+    add column frag_size integer not null default 0,
+    add column payload bytea null,
+    add column redundancy integer not null default 0,
+    add column fragmentation_session_index smallint not null default 0,
+    add column fragmentation_matrix smallint not null default 0,
+    add column block_ack_delay smallint not null default 0,
+    add column descriptor bytea null,
+    add column request_fragmentation_session_status varchar(30) not null default '',
+    add column multicast_group_id varchar(36) not null default '',
+    add column mc_addr bytea not null default '\x00000000',
+    add column mc_key bytea not null default '\x00000000000000000000000000000000',
+    add column session_start_time timestamp with time zone null,
+    add column session_end_time timestamp with time zone null,
+    add column mc_group_devices_added_at timestamp with time zone null,
+    add column mc_group_deleted_at timestamp with time zone null,
+    add column completed_at timestamp with time zone null;
+
+-- Deployments created before this migration can not be resumed as their
+-- options were never stored.
+update deployment set completed_at = updated_at;
+
+create index idx_deployment_completed_at on deployment(completed_at);
+
+alter table deployment_device
+    add column mc_root_key bytea not null default '\x00000000000000000000000000000000';
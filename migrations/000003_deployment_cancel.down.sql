@@ -0,0 +1,4 @@
+drop index idx_deployment_cancelled_at;
+
+alter table deployment
+    drop column cancelled_at;
@@ -0,0 +1,4 @@
+alter table deployment
+    add column cancelled_at timestamp with time zone null;
+
+create index idx_deployment_cancelled_at on deployment(cancelled_at);
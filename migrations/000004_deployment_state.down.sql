@@ -0,0 +1,5 @@
+drop index idx_deployment_state;
+
+alter table deployment
+    drop column state,
+    drop column error_message;
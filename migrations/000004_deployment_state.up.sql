@@ -0,0 +1,10 @@
+alter table deployment
+    add column state varchar(20) not null default 'PENDING',
+    add column error_message text not null default '';
+
+-- The outcome of the existing deployments is not known, as completed_at is
+-- also set for the deployments that failed or were abandoned (see 000002).
+update deployment set state = 'UNKNOWN';
+update deployment set state = 'CANCELLED' where cancelled_at is not null;
+
+create index idx_deployment_state on deployment(state);
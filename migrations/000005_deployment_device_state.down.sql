@@ -0,0 +1,3 @@
+alter table deployment_device
+    drop column state,
+    drop column failure_reason;
@@ -0,0 +1,8 @@
+alter table deployment_device
+    add column state varchar(20) not null default 'PENDING',
+    add column failure_reason varchar(50) not null default '';
+
+-- The devices of the existing deployments did either complete or their
+-- outcome is not known, as these deployments are no longer running.
+update deployment_device set state = 'UNKNOWN';
+update deployment_device set state = 'COMPLETED' where frag_status_completed_at is not null;
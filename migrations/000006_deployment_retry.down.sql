@@ -0,0 +1,4 @@
+drop index idx_deployment_parent_deployment_id;
+
+alter table deployment
+    drop column parent_deployment_id;
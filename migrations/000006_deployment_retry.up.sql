@@ -0,0 +1,4 @@
+alter table deployment
+    add column parent_deployment_id uuid null references deployment on delete set null;
+
+create index idx_deployment_parent_deployment_id on deployment(parent_deployment_id);
@@ -0,0 +1,3 @@
+alter table deployment
+    drop column payload_size,
+    drop column payload_sha256;
@@ -0,0 +1,7 @@
+alter table deployment
+    add column payload_size integer not null default 0,
+    add column payload_sha256 bytea null;
+
+update deployment set
+    payload_size = coalesce(length(payload), 0),
+    payload_sha256 = sha256(coalesce(payload, ''::bytea));
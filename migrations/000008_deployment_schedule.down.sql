@@ -0,0 +1,7 @@
+drop index idx_deployment_maintenance_window_deployment_id;
+drop table deployment_maintenance_window;
+
+drop index idx_deployment_start_at;
+
+alter table deployment
+    drop column start_at;
@@ -0,0 +1,15 @@
+alter table deployment
+    add column start_at timestamp with time zone null;
+
+update deployment set start_at = created_at;
+
+create index idx_deployment_start_at on deployment(start_at);
+
+create table deployment_maintenance_window (
+    id bigserial primary key,
+    deployment_id uuid not null references deployment on delete cascade,
+    start_at timestamp with time zone not null,
+    end_at timestamp with time zone not null
+);
+
+create index idx_deployment_maintenance_window_deployment_id on deployment_maintenance_window(deployment_id);
@@ -0,0 +1,8 @@
+drop index idx_deployment_campaign_id;
+
+alter table deployment
+    drop column campaign_id,
+    drop column campaign_wave;
+
+drop index idx_campaign_state;
+drop table campaign;
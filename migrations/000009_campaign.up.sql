@@ -0,0 +1,18 @@
+create table campaign (
+    id uuid primary key not null,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    application_id varchar(36) not null,
+    success_threshold double precision not null,
+    state varchar(20) not null default 'RUNNING',
+    error_message text not null default '',
+    completed_at timestamp with time zone null
+);
+
+create index idx_campaign_state on campaign(state);
+
+alter table deployment
+    add column campaign_id uuid null references campaign on delete cascade,
+    add column campaign_wave integer not null default 0;
+
+create index idx_deployment_campaign_id on deployment(campaign_id);
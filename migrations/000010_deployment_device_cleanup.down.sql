@@ -0,0 +1,2 @@
+alter table deployment
+    drop column device_cleanup_completed_at;
@@ -0,0 +1,2 @@
+alter table deployment
+    add column device_cleanup_completed_at timestamp with time zone null;
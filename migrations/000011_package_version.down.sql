@@ -0,0 +1,6 @@
+alter table deployment_device
+    drop column package_version_completed_at;
+
+alter table deployment
+    drop column package_version_completed_at,
+    drop column request_package_versions;
@@ -0,0 +1,6 @@
+alter table deployment
+    add column request_package_versions boolean not null default false,
+    add column package_version_completed_at timestamp with time zone null;
+
+alter table deployment_device
+    add column package_version_completed_at timestamp with time zone null;
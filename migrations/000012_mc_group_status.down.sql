@@ -0,0 +1,8 @@
+drop table deployment_device_mc_group;
+
+alter table deployment_device
+    drop column mc_group_status_completed_at;
+
+alter table deployment
+    drop column mc_group_status_completed_at,
+    drop column auto_mc_group_id;
@@ -0,0 +1,17 @@
+alter table deployment
+    add column auto_mc_group_id boolean not null default false,
+    add column mc_group_status_completed_at timestamp with time zone null;
+
+alter table deployment_device
+    add column mc_group_status_completed_at timestamp with time zone null;
+
+create table deployment_device_mc_group (
+    deployment_id uuid not null,
+    dev_eui bytea not null,
+    mc_group_id smallint not null,
+    mc_addr bytea not null,
+    created_at timestamp with time zone not null,
+
+    primary key (deployment_id, dev_eui, mc_group_id),
+    foreign key (deployment_id, dev_eui) references deployment_device on delete cascade
+);
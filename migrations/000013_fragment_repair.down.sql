@@ -0,0 +1,4 @@
+alter table deployment
+    drop column frag_repair_completed_at,
+    drop column repair_frag_count,
+    drop column repair_attempt_count;
@@ -0,0 +1,4 @@
+alter table deployment
+    add column repair_attempt_count integer not null default 0,
+    add column repair_frag_count integer not null default 0,
+    add column frag_repair_completed_at timestamp with time zone null;
@@ -0,0 +1,6 @@
+alter table deployment_device
+    drop column frag_data_block_received_at,
+    drop column frag_session_cnt;
+
+alter table deployment
+    drop column fragmentation_package_version;
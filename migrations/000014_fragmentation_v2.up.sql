@@ -0,0 +1,6 @@
+alter table deployment
+    add column fragmentation_package_version smallint not null default 1;
+
+alter table deployment_device
+    add column frag_session_cnt integer not null default 0,
+    add column frag_data_block_received_at timestamp with time zone null;
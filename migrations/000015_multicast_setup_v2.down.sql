@@ -0,0 +1,2 @@
+alter table deployment
+    drop column multicast_setup_package_version;
@@ -0,0 +1,2 @@
+alter table deployment
+    add column multicast_setup_package_version smallint not null default 1;
@@ -0,0 +1,18 @@
+alter table deployment_device
+    drop column fw_version_completed_at,
+    drop column reboot_completed_at,
+    drop column upgrade_image_completed_at,
+    drop column fw_version_after,
+    drop column fw_version_next,
+    drop column fw_version_before,
+    drop column hw_version;
+
+alter table deployment
+    drop column fw_version_completed_at,
+    drop column reboot_completed_at,
+    drop column upgrade_image_completed_at,
+    drop column dev_version_completed_at,
+    drop column reboot_countdown,
+    drop column reboot_at,
+    drop column firmware_version,
+    drop column firmware_management;
@@ -0,0 +1,18 @@
+alter table deployment
+    add column firmware_management boolean not null default false,
+    add column firmware_version bigint not null default 0,
+    add column reboot_at timestamp with time zone null,
+    add column reboot_countdown bigint not null default 0,
+    add column dev_version_completed_at timestamp with time zone null,
+    add column upgrade_image_completed_at timestamp with time zone null,
+    add column reboot_completed_at timestamp with time zone null,
+    add column fw_version_completed_at timestamp with time zone null;
+
+alter table deployment_device
+    add column hw_version bigint null,
+    add column fw_version_before bigint null,
+    add column fw_version_next bigint null,
+    add column fw_version_after bigint null,
+    add column upgrade_image_completed_at timestamp with time zone null,
+    add column reboot_completed_at timestamp with time zone null,
+    add column fw_version_completed_at timestamp with time zone null;
@@ -0,0 +1,7 @@
+drop index idx_deployment_firmware_id;
+
+alter table deployment
+    drop column firmware_id;
+
+drop index idx_firmware_hardware_version;
+drop table firmware;
@@ -0,0 +1,18 @@
+create table firmware (
+    id uuid primary key not null,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    version varchar(100) not null,
+    hardware varchar(100) not null,
+    payload bytea not null,
+    payload_size integer not null,
+    payload_sha256 bytea not null,
+    metadata hstore
+);
+
+create unique index idx_firmware_hardware_version on firmware(hardware, version);
+
+alter table deployment
+    add column firmware_id uuid null references firmware on delete set null;
+
+create index idx_deployment_firmware_id on deployment(firmware_id);
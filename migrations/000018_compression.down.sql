@@ -0,0 +1,3 @@
+alter table deployment
+    drop column compressed_payload_size,
+    drop column compression;
@@ -0,0 +1,5 @@
+alter table deployment
+    add column compression varchar(20) not null default 'NO_COMPRESSION',
+    add column compressed_payload_size integer not null default 0;
+
+update deployment set compressed_payload_size = payload_size;
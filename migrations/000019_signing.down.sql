@@ -0,0 +1,5 @@
+alter table deployment
+    drop column payload_signed;
+
+alter table firmware
+    drop column signature;
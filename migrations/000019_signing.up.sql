@@ -0,0 +1,5 @@
+alter table firmware
+    add column signature bytea null;
+
+alter table deployment
+    add column payload_signed boolean not null default false;
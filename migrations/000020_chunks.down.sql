@@ -0,0 +1,7 @@
+alter table deployment_device
+    drop column chunks_completed;
+
+alter table deployment
+    drop column chunks_completed,
+    drop column chunk_count,
+    drop column chunk_size;
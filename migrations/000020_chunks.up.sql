@@ -0,0 +1,7 @@
+alter table deployment
+    add column chunk_size integer not null default 0,
+    add column chunk_count integer not null default 1,
+    add column chunks_completed integer not null default 0;
+
+alter table deployment_device
+    add column chunks_completed integer not null default 0;